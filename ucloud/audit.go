@@ -0,0 +1,219 @@
+package ucloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// auditLogFormatText is the format to write audit record as a line of key=value pairs
+	auditLogFormatText = "text"
+
+	// auditLogFormatJSON is the format to write audit record as a line of json
+	auditLogFormatJSON = "json"
+
+	// redactedValue is the placeholder of sensitive parameter in audit record
+	redactedValue = "******"
+)
+
+// sensitiveParamKeywords is the keywords of sensitive parameter, which is matched case-insensitively
+var sensitiveParamKeywords = []string{
+	"signature",
+	"publickey",
+	"privatekey",
+	"password",
+	"userdata",
+}
+
+// auditRecord is the structured record of an api action
+type auditRecord struct {
+	Time       string            `json:"time"`
+	Action     string            `json:"action"`
+	Region     string            `json:"region"`
+	ProjectId  string            `json:"project_id"`
+	Duration   int64             `json:"duration_ms"`
+	StatusCode int               `json:"status_code"`
+	RetCode    int               `json:"ret_code"`
+	Message    string            `json:"message,omitempty"`
+	RequestId  string            `json:"request_id"`
+	Error      string            `json:"error,omitempty"`
+	Params     map[string]string `json:"params"`
+}
+
+func newAuditRecord(req *http.Request, resp *http.Response, body []byte, err error, duration time.Duration) *auditRecord {
+	query := req.URL.Query()
+
+	record := &auditRecord{
+		Time:      time.Now().Format(time.RFC3339),
+		Action:    query.Get("Action"),
+		Region:    query.Get("Region"),
+		ProjectId: query.Get("ProjectId"),
+		Duration:  int64(duration / time.Millisecond),
+		Params:    map[string]string{},
+	}
+
+	for k, v := range query {
+		if k == "Action" || k == "Region" || k == "ProjectId" || len(v) < 1 {
+			continue
+		}
+
+		if isSensitiveParam(k) {
+			record.Params[k] = redactedValue
+		} else {
+			record.Params[k] = v[0]
+		}
+	}
+
+	if err != nil {
+		record.Error = err.Error()
+		return record
+	}
+
+	record.StatusCode = resp.StatusCode
+	record.RequestId = resp.Header.Get("X-UCloud-Request-UUID")
+
+	var common struct {
+		RetCode     int
+		Message     string
+		RequestUUID string
+	}
+
+	if err := json.Unmarshal(body, &common); err == nil {
+		record.RetCode = common.RetCode
+		record.Message = common.Message
+
+		if record.RequestId == "" {
+			record.RequestId = common.RequestUUID
+		}
+	}
+
+	return record
+}
+
+func isSensitiveParam(key string) bool {
+	key = strings.ToLower(key)
+	for _, keyword := range sensitiveParamKeywords {
+		if strings.Contains(key, keyword) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// text will returns the record as a line of key=value pairs
+func (r *auditRecord) text() string {
+	keys := []string{}
+	for k := range r.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	params := []string{}
+	for _, k := range keys {
+		params = append(params, fmt.Sprintf("%s=%s", k, r.Params[k]))
+	}
+
+	line := fmt.Sprintf("%s action=%s region=%s project_id=%s duration_ms=%d status_code=%d ret_code=%d request_id=%s",
+		r.Time, r.Action, r.Region, r.ProjectId, r.Duration, r.StatusCode, r.RetCode, r.RequestId)
+
+	if r.Message != "" {
+		line = fmt.Sprintf("%s message=%q", line, r.Message)
+	}
+
+	if r.Error != "" {
+		line = fmt.Sprintf("%s error=%q", line, r.Error)
+	}
+
+	return fmt.Sprintf("%s params=[%s]", line, strings.Join(params, " "))
+}
+
+// auditLogger is used to write the audit record of each api action,
+// the record is always written to debug log, and also written to log file if it is specified.
+type auditLogger struct {
+	mu     sync.Mutex
+	writer io.Writer
+	format string
+}
+
+func newAuditLogger(logFile, logFormat string) (*auditLogger, error) {
+	logger := &auditLogger{format: logFormat}
+	if logger.format == "" {
+		logger.format = auditLogFormatText
+	}
+
+	if logFile == "" {
+		return logger, nil
+	}
+
+	path, err := homedir.Expand(logFile)
+	if err != nil {
+		return nil, fmt.Errorf("error on expanding log file %q, %s", logFile, err)
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("error on opening log file %q, %s", logFile, err)
+	}
+
+	logger.writer = file
+	return logger, nil
+}
+
+func (l *auditLogger) log(record *auditRecord) {
+	log.Printf("[DEBUG] ucloud api audit: %s", record.text())
+
+	if l == nil {
+		return
+	}
+
+	var line string
+	if l.format == auditLogFormatJSON {
+		bytes, err := json.Marshal(record)
+		if err != nil {
+			log.Printf("[WARN] error on encoding audit record of %s, %s", record.Action, err)
+			return
+		}
+		line = string(bytes)
+	} else {
+		line = record.text()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.writer == nil {
+		return
+	}
+
+	if _, err := fmt.Fprintln(l.writer, line); err != nil {
+		log.Printf("[WARN] error on writing audit record of %s, %s", record.Action, err)
+	}
+}
+
+// close will close the log file, the record is only written to debug log after closed
+func (l *auditLogger) close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	closer, ok := l.writer.(io.Closer)
+	l.writer = nil
+	if !ok {
+		return nil
+	}
+	return closer.Close()
+}
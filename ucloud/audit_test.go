@@ -0,0 +1,130 @@
+package ucloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func Test_isSensitiveParam(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"Signature", true},
+		{"PublicKey", true},
+		{"Password", true},
+		{"LoginPassword", true},
+		{"UserData", true},
+		{"UHostId", false},
+		{"Name", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := isSensitiveParam(tt.key); got != tt.want {
+				t.Errorf("isSensitiveParam() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_auditLogger_json(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(tempdir)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-UCloud-Request-UUID", "request-uuid")
+		fmt.Fprint(w, `{"Action": "ResetUHostInstancePasswordResponse", "RetCode": 0}`)
+	}))
+	defer server.Close()
+
+	logFile := path.Join(tempdir, "audit.log")
+	transport, err := newUCloudTransport(&Config{LogFile: logFile, LogFormat: auditLogFormatJSON}, 0)
+	if err != nil {
+		t.Fatalf("newUCloudTransport() error = %v", err)
+	}
+	defer transport.auditLogger.close()
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL + "?Action=ResetUHostInstancePassword&Region=cn-bj2&ProjectId=org-xxx&UHostId=uhost-xxx&Password=secret&Signature=sig")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+
+	content, err := ioutil.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("audit record count = %d, want 1", len(lines))
+	}
+
+	var record auditRecord
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if record.Action != "ResetUHostInstancePassword" || record.Region != "cn-bj2" || record.ProjectId != "org-xxx" {
+		t.Errorf("record = %+v, want action, region and project id", record)
+	}
+
+	if record.RequestId != "request-uuid" || record.RetCode != 0 || record.StatusCode != http.StatusOK {
+		t.Errorf("record = %+v, want request id and ret code", record)
+	}
+
+	if record.Params["Password"] != redactedValue || record.Params["Signature"] != redactedValue {
+		t.Errorf("params = %v, want sensitive params redacted", record.Params)
+	}
+
+	if record.Params["UHostId"] != "uhost-xxx" {
+		t.Errorf("params = %v, want UHostId present", record.Params)
+	}
+
+	if strings.Contains(string(content), "secret") {
+		t.Errorf("audit log contains password, %s", content)
+	}
+}
+
+func Test_auditLogger_close(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(tempdir)
+
+	logFile := path.Join(tempdir, "audit.log")
+	auditLogger, err := newAuditLogger(logFile, auditLogFormatText)
+	if err != nil {
+		t.Fatalf("newAuditLogger() error = %v", err)
+	}
+
+	if err := auditLogger.close(); err != nil {
+		t.Fatalf("close() error = %v", err)
+	}
+
+	// the record is only written to debug log after closed
+	auditLogger.log(&auditRecord{Action: "DescribeUHostInstance", Params: map[string]string{}})
+
+	content, err := ioutil.ReadFile(logFile)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	if len(content) != 0 {
+		t.Errorf("audit log = %s, want empty after closed", content)
+	}
+}
@@ -0,0 +1,424 @@
+package ucloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+const (
+	// cassetteModeRecord is the mode to save the api interactions of real acceptance tests
+	cassetteModeRecord = "record"
+
+	// cassetteDir is the directory of cassette fixtures
+	cassetteDir = "testdata/cassettes"
+
+	// cassetteProjectId is the placeholder of project id in cassette fixtures
+	cassetteProjectId = "org-cassette"
+)
+
+// cassetteResourceIdRegexp matches the resource id in response body, such as uhost-xxx,
+// the first group is the character before id, which is used to skip the names such as tf-acc-rule-basic.
+var cassetteResourceIdRegexp = regexp.MustCompile(`(^|[^A-Za-z0-9_-])((uhost|uimage|bsm|bsi|eip|ulb|vserver|backend|rule|policy|firewall|uvnet|subnet|vpc|vip|bwpackage|bwshare|snapshot|ip)-[a-z0-9]{4,})\b`)
+
+// cassetteIPRegexp matches the IPv4 address in response body
+var cassetteIPRegexp = regexp.MustCompile(`\b\d{1,3}(\.\d{1,3}){3}\b`)
+
+// cassetteNonPublicNetworks is the networks of IP which are not scrubbed, such as the private IP of subnet
+var cassetteNonPublicNetworks = []string{"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12", "192.168.0.0/16", "255.0.0.0/8"}
+
+// cassetteIPPlaceholderNetworks is the networks reserved for documentation, which are used to replace the public IP
+var cassetteIPPlaceholderNetworks = []string{"203.0.113", "198.51.100", "192.0.2"}
+
+var cassetteMu sync.Mutex
+var cassetteConfigureOnce sync.Once
+
+// testCassetteRecorder records the api interactions of the test in use,
+// it is shared by the clients configured by testAccProvider.
+var testCassetteRecorder = &cassetteRecorder{}
+
+// testCassette is the cassette used by a test, which is started by testAccPreCheck
+type testCassette struct {
+	path    string
+	started bool
+}
+
+var testCassettesMu sync.Mutex
+var testCassettes = map[*testing.T]*testCassette{}
+
+// useCassetteIfEnabled will record the api interactions of the test if UCLOUD_CASSETTE_MODE is set to record,
+// it returns the function to stop the cassette, which must be deferred by the test.
+// The cassette is started by testAccPreCheck after the parallel test is resumed,
+// and the tests with cassette are run one by one, because all of them share the same provider.
+func useCassetteIfEnabled(t *testing.T) func() {
+	mode := os.Getenv("UCLOUD_CASSETTE_MODE")
+	if mode == "" {
+		return func() {}
+	}
+
+	if mode != cassetteModeRecord {
+		t.Fatalf("UCLOUD_CASSETTE_MODE must be %q, got %q", cassetteModeRecord, mode)
+	}
+
+	tc := &testCassette{
+		path: filepath.Join(cassetteDir, strings.Replace(t.Name(), "/", "_", -1)+".json"),
+	}
+
+	testCassettesMu.Lock()
+	testCassettes[t] = tc
+	testCassettesMu.Unlock()
+
+	return func() {
+		testCassettesMu.Lock()
+		delete(testCassettes, t)
+		testCassettesMu.Unlock()
+
+		if !tc.started {
+			return
+		}
+		defer cassetteMu.Unlock()
+
+		c := testCassetteRecorder.stop()
+		if t.Failed() {
+			return
+		}
+
+		if err := c.save(tc.path); err != nil {
+			t.Errorf("error on saving cassette %s, %s", tc.path, err)
+		}
+	}
+}
+
+// startCassetteIfEnabled will start the cassette of test, which is prepared by useCassetteIfEnabled
+func startCassetteIfEnabled(t *testing.T) {
+	testCassettesMu.Lock()
+	tc := testCassettes[t]
+	testCassettesMu.Unlock()
+
+	if tc == nil {
+		if os.Getenv("UCLOUD_CASSETTE_MODE") != "" {
+			t.Fatalf("the test must defer useCassetteIfEnabled to run with UCLOUD_CASSETTE_MODE")
+		}
+		return
+	}
+
+	cassetteMu.Lock()
+	tc.started = true
+
+	cassetteConfigureOnce.Do(func() {
+		wrapCassetteConfigure(testAccProvider, testCassetteRecorder)
+	})
+	testCassetteRecorder.start(&cassette{Name: t.Name()}, os.Getenv("UCLOUD_PROJECT_ID"))
+}
+
+// wrapCassetteConfigure will wrap the configure func of provider,
+// so that the requests of each configured client are recorded by recorder before they are sent by its transport.
+func wrapCassetteConfigure(p *schema.Provider, recorder *cassetteRecorder) {
+	configure := p.ConfigureFunc
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		meta, err := configure(d)
+		if err != nil {
+			return nil, err
+		}
+
+		recorder.wrap(meta.(*UCloudClient))
+		return meta, nil
+	}
+}
+
+// cassetteInteraction is the sanitized request and response of an api action
+type cassetteInteraction struct {
+	Action     string            `json:"action"`
+	Params     map[string]string `json:"params"`
+	StatusCode int               `json:"status_code"`
+	Body       string            `json:"body"`
+}
+
+// cassette is the api interactions of an acceptance test
+type cassette struct {
+	Name         string                 `json:"name"`
+	Interactions []*cassetteInteraction `json:"interactions"`
+}
+
+func (c *cassette) save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// cassetteRecorder records the api interactions of the wrapped clients to cassette,
+// it is passthrough when there is no cassette in use.
+type cassetteRecorder struct {
+	mu        sync.Mutex
+	cassette  *cassette
+	projectId string
+
+	// scrubbed is the placeholders of resource ids and public IPs
+	scrubbed map[string]string
+	// scrubbedIdCount and scrubbedIPCount is the count of resource ids and public IPs replaced by placeholder
+	scrubbedIdCount int
+	scrubbedIPCount int
+}
+
+func (r *cassetteRecorder) start(c *cassette, projectId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette = c
+	r.projectId = projectId
+	r.scrubbed = map[string]string{}
+	r.scrubbedIdCount = 0
+	r.scrubbedIPCount = 0
+}
+
+func (r *cassetteRecorder) stop() *cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := r.cassette
+	r.cassette = nil
+	return c
+}
+
+// wrap will record the requests sent by the transport of client,
+// the throttled requests retried by client transport are recorded only once.
+func (r *cassetteRecorder) wrap(client *UCloudClient) {
+	client.transport.base = &cassetteTransport{base: client.transport.base, recorder: r}
+}
+
+// cassetteTransport is a http.RoundTripper which records the api interactions by recorder
+type cassetteTransport struct {
+	// base is used to send the request, http.DefaultTransport is used if it is nil
+	base     http.RoundTripper
+	recorder *cassetteRecorder
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	// the throttled request will be retried, so it is not recorded
+	if isThrottlingResponse(resp, body) {
+		return resp, nil
+	}
+
+	t.recorder.record(req, resp.StatusCode, body)
+	return resp, nil
+}
+
+func (r *cassetteRecorder) record(req *http.Request, statusCode int, body []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette == nil {
+		return
+	}
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &cassetteInteraction{
+		Action:     req.URL.Query().Get("Action"),
+		Params:     r.sanitizeParams(req),
+		StatusCode: statusCode,
+		Body:       r.sanitizeBody(string(body)),
+	})
+}
+
+// sanitizeParams will returns the parameters without credentials and project id,
+// and the resource ids and public IPs are replaced by the same placeholders as response body.
+func (r *cassetteRecorder) sanitizeParams(req *http.Request) map[string]string {
+	params := map[string]string{}
+	for k, v := range req.URL.Query() {
+		if k == "Action" || k == "ProjectId" || len(v) < 1 {
+			continue
+		}
+
+		if isSensitiveParam(k) {
+			params[k] = redactedValue
+		} else {
+			params[k] = r.scrub(v[0])
+		}
+	}
+	return params
+}
+
+// sanitizeBody will replace the project id, resource ids and public IPs of account in response body
+func (r *cassetteRecorder) sanitizeBody(body string) string {
+	if r.projectId != "" {
+		body = strings.Replace(body, r.projectId, cassetteProjectId, -1)
+	}
+	return r.scrub(body)
+}
+
+// scrub will replace the resource ids and public IPs by placeholders,
+// the same value is always replaced by the same placeholder in a cassette, so that the interactions can be matched.
+func (r *cassetteRecorder) scrub(s string) string {
+	s = cassetteResourceIdRegexp.ReplaceAllStringFunc(s, func(match string) string {
+		groups := cassetteResourceIdRegexp.FindStringSubmatch(match)
+		return groups[1] + r.scrubResourceId(groups[2])
+	})
+	return cassetteIPRegexp.ReplaceAllStringFunc(s, r.scrubIP)
+}
+
+func (r *cassetteRecorder) scrubResourceId(id string) string {
+	if _, ok := r.scrubbed[id]; !ok {
+		prefix := id[:strings.Index(id, "-")]
+		r.scrubbedIdCount++
+		r.scrubbed[id] = fmt.Sprintf("%s-cassette%04d", prefix, r.scrubbedIdCount)
+	}
+	return r.scrubbed[id]
+}
+
+func (r *cassetteRecorder) scrubIP(ip string) string {
+	if !isPublicIP(ip) {
+		return ip
+	}
+
+	if _, ok := r.scrubbed[ip]; !ok {
+		network := cassetteIPPlaceholderNetworks[(r.scrubbedIPCount/254)%len(cassetteIPPlaceholderNetworks)]
+		r.scrubbed[ip] = fmt.Sprintf("%s.%d", network, r.scrubbedIPCount%254+1)
+		r.scrubbedIPCount++
+	}
+	return r.scrubbed[ip]
+}
+
+func isPublicIP(s string) bool {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return false
+	}
+
+	for _, cidr := range cassetteNonPublicNetworks {
+		if _, network, _ := net.ParseCIDR(cidr); network.Contains(ip) {
+			return false
+		}
+	}
+
+	for _, prefix := range cassetteIPPlaceholderNetworks {
+		if strings.HasPrefix(s, prefix+".") {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestCassetteRecorder_scrub(t *testing.T) {
+	r := &cassetteRecorder{}
+	r.start(&cassette{Name: t.Name()}, "org-abc")
+
+	body := r.sanitizeBody(`{"EIPSet":[{"EIPId":"eip-abc123","EIPAddr":[{"IP":"106.75.1.2"}],"Resource":{"ResourceId":"uhost-abc123"},"PrivateIp":"10.9.1.2","ProjectId":"org-abc"}]}`)
+	want := `{"EIPSet":[{"EIPId":"eip-cassette0001","EIPAddr":[{"IP":"203.0.113.1"}],"Resource":{"ResourceId":"uhost-cassette0002"},"PrivateIp":"10.9.1.2","ProjectId":"org-cassette"}]}`
+	if body != want {
+		t.Errorf("sanitizeBody() = %s, want %s", body, want)
+	}
+
+	for param, want := range map[string]string{"uhost-abc123": "uhost-cassette0002", "106.75.1.2": "203.0.113.1", "tf-acc-eip-basic": "tf-acc-eip-basic", "eip eip-abc123 is not found": "eip eip-cassette0001 is not found"} {
+		if got := r.scrub(param); got != want {
+			t.Errorf("scrub(%s) = %s, want %s", param, got, want)
+		}
+	}
+}
+
+func TestCassetteRecorder_record(t *testing.T) {
+	client, server := newFakeAPITestClient(t)
+	defer server.Close()
+
+	other, otherServer := newFakeAPITestClient(t)
+	defer otherServer.Close()
+
+	defaultTransport := http.DefaultTransport
+	r := &cassetteRecorder{}
+	r.wrap(client)
+
+	if http.DefaultTransport != defaultTransport {
+		t.Fatalf("expected http.DefaultTransport is not replaced")
+	}
+
+	createVPC := func(client *UCloudClient, name string) (string, error) {
+		req := client.vpcconn.NewCreateVPCRequest()
+		req.Name = ucloud.String(name)
+		req.Network = []string{"192.168.0.0/16"}
+
+		resp, err := client.vpcconn.CreateVPC(req)
+		if err != nil {
+			return "", err
+		}
+		return resp.VPCId, nil
+	}
+
+	r.start(&cassette{Name: t.Name()}, fakeAPIProjectId)
+	vpcId, err := createVPC(client, "tf-acc-cassette-record")
+	if err != nil {
+		t.Fatalf("error on creating vpc, %s", err)
+	}
+
+	if _, err := client.describeVPCById(vpcId); err != nil {
+		t.Fatalf("error on reading vpc, %s", err)
+	}
+
+	// the client which is not wrapped is not recorded
+	if _, err := createVPC(other, "tf-acc-cassette-other"); err != nil {
+		t.Fatalf("error on creating vpc by other client, %s", err)
+	}
+	c := r.stop()
+
+	// the client is passthrough after the cassette is stopped
+	if _, err := client.describeVPCById(vpcId); err != nil {
+		t.Fatalf("error on reading vpc after stopping cassette, %s", err)
+	}
+
+	if len(c.Interactions) != 2 {
+		t.Fatalf("expected 2 interactions, got %d", len(c.Interactions))
+	}
+
+	if c.Interactions[0].Action != "CreateVPC" || c.Interactions[1].Action != "DescribeVPC" {
+		t.Fatalf("expected CreateVPC and DescribeVPC are recorded, got %s and %s", c.Interactions[0].Action, c.Interactions[1].Action)
+	}
+
+	for _, i := range c.Interactions {
+		if strings.Contains(i.Body, vpcId) || strings.Contains(i.Params["VPCIds.0"], vpcId) {
+			t.Fatalf("expected vpc id is scrubbed, got %v, %s", i.Params, i.Body)
+		}
+
+		if i.Params["Signature"] != redactedValue || i.Params["PublicKey"] != redactedValue {
+			t.Fatalf("expected credentials are redacted, got %v", i.Params)
+		}
+
+		if _, ok := i.Params["ProjectId"]; ok {
+			t.Fatalf("expected project id is removed, got %v", i.Params)
+		}
+	}
+}
@@ -1,6 +1,12 @@
 package ucloud
 
 import (
+	"fmt"
+	"os"
+
+	"github.com/go-ini/ini"
+	"github.com/mitchellh/go-homedir"
+
 	"github.com/ucloud/ucloud-sdk-go/services/uaccount"
 	"github.com/ucloud/ucloud-sdk-go/services/udisk"
 	"github.com/ucloud/ucloud-sdk-go/services/uhost"
@@ -18,9 +24,26 @@ type Config struct {
 	Region     string
 	ProjectId  string
 
+	SharedCredentialsFile string
+	Profile               string
+
 	MaxRetries int
 
+	// MaxRequestsPerSecond and MaxConcurrentRequests are used to limit all of api requests of client, zero means unlimited
+	MaxRequestsPerSecond  int
+	MaxConcurrentRequests int
+
 	Insecure bool
+
+	// LogFile and LogFormat is used to write the audit record of each api action
+	LogFile   string
+	LogFormat string
+
+	// BaseUrl is the endpoint for all of products
+	BaseUrl string
+
+	// Endpoints is the endpoint of each product, such as uhost, unet, etc.
+	Endpoints map[string]string
 }
 
 type UCloudClient struct {
@@ -33,6 +56,12 @@ type UCloudClient struct {
 	vpcconn      *vpc.VPCClient
 	uaccountconn *uaccount.UAccountClient
 	udiskconn    *udisk.UDiskClient
+
+	// udiskextconn is used for the disk actions which are not supported by sdk yet
+	udiskextconn *udiskExtClient
+
+	// transport is used to send the api requests of all connections
+	transport *ucloudTransport
 }
 
 // Client will returns a client with connections for all product
@@ -48,27 +77,138 @@ func (c *Config) Client() (*UCloudClient, error) {
 
 	// enable auto retry with http/connection error
 	config.MaxRetries = c.MaxRetries
-	config.LogLevel = log.DebugLevel
 
-	// set endpoint with insecure https connection
-	if c.Insecure {
-		config.BaseUrl = GetEndpointURL(c.Region)
-	} else {
-		config.BaseUrl = GetInsecureEndpointURL(c.Region)
+	// the raw request is not dumped by sdk, because it contains signature and password,
+	// the redacted audit record of each api action is logged by transport instead.
+	config.LogLevel = log.InfoLevel
+
+	// limit the rate and concurrency of api requests, and retry the throttled requests,
+	// the timeout is applied to each attempt by transport, so that the backoff delay is not counted in it.
+	transport, err := newUCloudTransport(c, config.Timeout)
+	if err != nil {
+		return nil, err
 	}
+	config.Transport = transport
+	config.Timeout = 0
+	client.transport = transport
 
 	// credential with publicKey/privateKey
 	credential := auth.NewCredential()
 	credential.PublicKey = c.PublicKey
 	credential.PrivateKey = c.PrivateKey
 
-	// initialize client connections
-	client.uhostconn = uhost.NewClient(&config, &credential)
-	client.unetconn = unet.NewClient(&config, &credential)
-	client.ulbconn = ulb.NewClient(&config, &credential)
-	client.vpcconn = vpc.NewClient(&config, &credential)
-	client.uaccountconn = uaccount.NewClient(&config, &credential)
-	client.udiskconn = udisk.NewClient(&config, &credential)
+	// initialize client connections, each product may have its own endpoint
+	uhostConfig := c.newProductConfig(config, "uhost")
+	unetConfig := c.newProductConfig(config, "unet")
+	ulbConfig := c.newProductConfig(config, "ulb")
+	vpcConfig := c.newProductConfig(config, "vpc")
+	uaccountConfig := c.newProductConfig(config, "uaccount")
+	udiskConfig := c.newProductConfig(config, "udisk")
+
+	client.uhostconn = uhost.NewClient(&uhostConfig, &credential)
+	client.unetconn = unet.NewClient(&unetConfig, &credential)
+	client.ulbconn = ulb.NewClient(&ulbConfig, &credential)
+	client.vpcconn = vpc.NewClient(&vpcConfig, &credential)
+	client.uaccountconn = uaccount.NewClient(&uaccountConfig, &credential)
+	client.udiskconn = udisk.NewClient(&udiskConfig, &credential)
+	client.udiskextconn = newUDiskExtClient(&udiskConfig, &credential)
 
 	return &client, nil
 }
+
+// close will release the resources of client, such as the audit log file
+func (client *UCloudClient) close() error {
+	return client.transport.auditLogger.close()
+}
+
+// newProductConfig will returns a copy of sdk config with the endpoint of product
+func (c *Config) newProductConfig(config ucloud.Config, product string) ucloud.Config {
+	config.BaseUrl = c.getEndpointURL(product)
+	return config
+}
+
+// loadSharedCredentials will fill the empty credential arguments by the profile of shared credentials file,
+// and then check the required arguments are present.
+func (c *Config) loadSharedCredentials() error {
+	if c.PublicKey == "" || c.PrivateKey == "" || c.Region == "" || c.ProjectId == "" {
+		section, err := loadSharedCredentialsProfile(c.SharedCredentialsFile, c.Profile)
+		if err != nil {
+			return err
+		}
+
+		if section != nil {
+			if c.PublicKey == "" {
+				c.PublicKey = section.Key("public_key").String()
+			}
+
+			if c.PrivateKey == "" {
+				c.PrivateKey = section.Key("private_key").String()
+			}
+
+			if c.Region == "" {
+				c.Region = section.Key("region").String()
+			}
+
+			if c.ProjectId == "" {
+				c.ProjectId = section.Key("project_id").String()
+			}
+		}
+	}
+
+	if c.PublicKey == "" {
+		return fmt.Errorf("public_key is required, it can be set by argument, environment variable UCLOUD_PUBLIC_KEY or shared credentials file")
+	}
+
+	if c.PrivateKey == "" {
+		return fmt.Errorf("private_key is required, it can be set by argument, environment variable UCLOUD_PRIVATE_KEY or shared credentials file")
+	}
+
+	if c.Region == "" {
+		return fmt.Errorf("region is required, it can be set by argument, environment variable UCLOUD_REGION or shared credentials file")
+	}
+
+	if c.ProjectId == "" {
+		return fmt.Errorf("project_id is required, it can be set by argument, environment variable UCLOUD_PROJECT_ID or shared credentials file")
+	}
+
+	return nil
+}
+
+// loadSharedCredentialsProfile will returns the section of profile in shared credentials file,
+// it returns nil without error if the default file or default profile is not present.
+func loadSharedCredentialsProfile(filename, profile string) (*ini.Section, error) {
+	if filename == "" {
+		filename = defaultSharedCredentialsFile
+	}
+
+	if profile == "" {
+		profile = defaultProfile
+	}
+
+	path, err := homedir.Expand(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error on expanding shared credentials file %q, %s", filename, err)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) && filename == defaultSharedCredentialsFile {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error on loading shared credentials file %q, %s", filename, err)
+	}
+
+	file, err := ini.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("error on parsing shared credentials file %q, %s", filename, err)
+	}
+
+	section, err := file.GetSection(profile)
+	if err != nil {
+		if profile == defaultProfile {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("profile %q is not found in shared credentials file %q", profile, filename)
+	}
+
+	return section, nil
+}
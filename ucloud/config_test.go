@@ -0,0 +1,119 @@
+package ucloud
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+)
+
+func TestConfig_loadSharedCredentials(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "test")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(tempdir)
+
+	filePath := path.Join(tempdir, "credential.ini")
+	content := `
+[default]
+public_key  = default-public-key
+private_key = default-private-key
+region      = cn-bj2
+project_id  = org-default
+
+[foo]
+public_key  = foo-public-key
+private_key = foo-private-key
+region      = cn-sh2
+project_id  = org-foo
+
+[bar]
+region = cn-gd
+`
+	if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Error(err)
+		return
+	}
+
+	tests := []struct {
+		name    string
+		config  Config
+		want    Config
+		wantErr bool
+	}{
+		{
+			"ok_default_profile",
+			Config{SharedCredentialsFile: filePath},
+			Config{PublicKey: "default-public-key", PrivateKey: "default-private-key", Region: "cn-bj2", ProjectId: "org-default"},
+			false,
+		},
+		{
+			"ok_named_profile",
+			Config{SharedCredentialsFile: filePath, Profile: "foo"},
+			Config{PublicKey: "foo-public-key", PrivateKey: "foo-private-key", Region: "cn-sh2", ProjectId: "org-foo"},
+			false,
+		},
+		{
+			"ok_explicit_first",
+			Config{SharedCredentialsFile: filePath, Profile: "foo", Region: "cn-gd", ProjectId: "org-explicit"},
+			Config{PublicKey: "foo-public-key", PrivateKey: "foo-private-key", Region: "cn-gd", ProjectId: "org-explicit"},
+			false,
+		},
+		{
+			"ok_without_file",
+			Config{SharedCredentialsFile: filePath, PublicKey: "pub", PrivateKey: "priv", Region: "cn-bj2", ProjectId: "org-xxx"},
+			Config{PublicKey: "pub", PrivateKey: "priv", Region: "cn-bj2", ProjectId: "org-xxx"},
+			false,
+		},
+		{
+			"err_profile_not_found",
+			Config{SharedCredentialsFile: filePath, Profile: "baz"},
+			Config{},
+			true,
+		},
+		{
+			"err_file_not_found",
+			Config{SharedCredentialsFile: path.Join(tempdir, "not_found.ini")},
+			Config{},
+			true,
+		},
+		{
+			"err_missing_required",
+			Config{SharedCredentialsFile: filePath, Profile: "bar"},
+			Config{},
+			true,
+		},
+		{
+			"err_missing_project_id",
+			Config{SharedCredentialsFile: filePath, Profile: "bar", PublicKey: "pub", PrivateKey: "priv"},
+			Config{},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.loadSharedCredentials()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("loadSharedCredentials() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			got := Config{
+				PublicKey:  tt.config.PublicKey,
+				PrivateKey: tt.config.PrivateKey,
+				Region:     tt.config.Region,
+				ProjectId:  tt.config.ProjectId,
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("loadSharedCredentials() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
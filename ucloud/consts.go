@@ -22,6 +22,12 @@ const (
 
 	// defaultTag is the default tag for all of resources
 	defaultTag = "Default"
+
+	// defaultSharedCredentialsFile is the default path of shared credentials file
+	defaultSharedCredentialsFile = "~/.ucloud/credential.ini"
+
+	// defaultProfile is the default profile name in shared credentials file
+	defaultProfile = "default"
 )
 
 const (
@@ -63,6 +69,22 @@ var titleCaseProdCvt = newStringConverter(map[string]string{
 	"lb":       "ULB",
 })
 
+// securityGroupResourceTypeCvt is used to covert the resource type of security group attachment, such as physical_instance to UPHost
+var securityGroupResourceTypeCvt = newStringConverter(map[string]string{
+	"instance":          "UHost",
+	"lb":                "ULB",
+	"physical_instance": "UPHost",
+	"nat_gateway":       "UNatGW",
+})
+
+// diskTypeCvt is used to covert the disk type of udisk, such as ssd_data_disk to SSDDataDisk
+var diskTypeCvt = newStringConverter(map[string]string{
+	"data_disk":       "DataDisk",
+	"ssd_data_disk":   "SSDDataDisk",
+	"system_disk":     "SystemDisk",
+	"ssd_system_disk": "SSDSystemDisk",
+})
+
 // dbModeCvt is used to covert basic to Normal and convert ha to HA
 var dbModeCvt = newStringConverter(map[string]string{
 	"basic": "Normal",
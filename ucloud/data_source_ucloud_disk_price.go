@@ -0,0 +1,104 @@
+package ucloud
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/udisk"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func dataSourceUCloudDiskPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudDiskPriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"disk_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4000),
+			},
+
+			"disk_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "data_disk",
+				ValidateFunc: validation.StringInSlice([]string{"data_disk", "ssd_data_disk"}, false),
+			},
+
+			"charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "month",
+				ValidateFunc: validation.StringInSlice([]string{"year", "month", "dynamic"}, false),
+			},
+
+			"duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateDuration,
+			},
+
+			"data_ark": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"price": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceUCloudDiskPriceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).udiskconn
+
+	chargeType := upperCamelCvt.unconvert(d.Get("charge_type").(string))
+
+	req := conn.NewDescribeUDiskPriceRequest()
+	req.Zone = ucloud.String(d.Get("availability_zone").(string))
+	req.Size = ucloud.Int(d.Get("disk_size").(int))
+	req.DiskType = ucloud.String(diskTypeCvt.convert(d.Get("disk_type").(string)))
+	req.ChargeType = ucloud.String(chargeType)
+	req.Quantity = ucloud.Int(d.Get("duration").(int))
+	req.UDataArkMode = ucloud.String(boolCamelCvt.convert(d.Get("data_ark").(bool)))
+
+	resp, err := conn.DescribeUDiskPrice(req)
+	if err != nil {
+		return fmt.Errorf("error on reading disk price, %s", err)
+	}
+
+	var price *udisk.UDiskPriceDataSet
+	for i := range resp.DataSet {
+		if resp.DataSet[i].ChargeType == chargeType {
+			price = &resp.DataSet[i]
+			break
+		}
+	}
+
+	if price == nil {
+		return fmt.Errorf("error on reading disk price, the price of charge type %s is not found", chargeType)
+	}
+
+	d.SetId(hashStringArray([]string{
+		d.Get("availability_zone").(string),
+		strconv.Itoa(d.Get("disk_size").(int)),
+		d.Get("disk_type").(string),
+		chargeType,
+		strconv.Itoa(d.Get("duration").(int)),
+	}))
+	d.Set("price", price.Price)
+
+	return nil
+}
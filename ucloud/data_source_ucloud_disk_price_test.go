@@ -0,0 +1,39 @@
+package ucloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudDiskPriceDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataDiskPriceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_disk_price.foo"),
+					testAccCheckPriceIsPositive("data.ucloud_disk_price.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_disk_price.foo", "disk_type", "ssd_data_disk"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataDiskPriceConfig = `
+data "ucloud_zones" "default" {}
+
+data "ucloud_disk_price" "foo" {
+	availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+	disk_size         = 20
+	disk_type         = "ssd_data_disk"
+	charge_type       = "year"
+}
+`
@@ -0,0 +1,209 @@
+package ucloud
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func dataSourceUCloudDiskSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudDiskSnapshotsRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"disk_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"disk_snapshots": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"disk_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"disk_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"disk_size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"charge_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"expire_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudDiskSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).udiskextconn
+
+	req := conn.NewDescribeUDiskSnapshotRequest()
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		req.Zone = ucloud.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disk_id"); ok {
+		req.UDiskId = ucloud.String(v.(string))
+	}
+
+	var snapshots []uDiskSnapshotSet
+	var limit int = 100
+	var offset int
+	for {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeUDiskSnapshot(req)
+		if err != nil {
+			return fmt.Errorf("error on reading disk snapshot list, %s", err)
+		}
+
+		if resp == nil || len(resp.DataSet) < 1 {
+			break
+		}
+
+		snapshots = append(snapshots, resp.DataSet...)
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	ids, idsOk := d.GetOk("ids")
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
+	var filteredSnapshots []uDiskSnapshotSet
+	for _, item := range snapshots {
+		if idsOk && !isStringIn(item.SnapshotId, schemaSetToStringSlice(ids)) {
+			continue
+		}
+
+		if nameRegexOk {
+			r := regexp.MustCompile(nameRegex.(string))
+			if !r.MatchString(item.Name) {
+				continue
+			}
+		}
+
+		filteredSnapshots = append(filteredSnapshots, item)
+	}
+
+	d.Set("total_count", len(filteredSnapshots))
+	err := dataSourceUCloudDiskSnapshotsSave(d, filteredSnapshots)
+	if err != nil {
+		return fmt.Errorf("error on reading disk snapshot list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudDiskSnapshotsSave(d *schema.ResourceData, snapshots []uDiskSnapshotSet) error {
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, item := range snapshots {
+		ids = append(ids, item.SnapshotId)
+		data = append(data, map[string]interface{}{
+			"id":          item.SnapshotId,
+			"name":        item.Name,
+			"disk_id":     item.UDiskId,
+			"disk_name":   item.UDiskName,
+			"disk_size":   item.Size,
+			"description": item.Comment,
+			"charge_type": upperCamelCvt.convert(item.ChargeType),
+			"status":      item.Status,
+			"create_time": timestampToString(item.CreateTime),
+			"expire_time": timestampToString(item.ExpiredTime),
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("disk_snapshots", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package ucloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudDiskSnapshotsDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataDiskSnapshotsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_disk_snapshots.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_disk_snapshots.foo", "disk_snapshots.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_disk_snapshots.foo", "disk_snapshots.0.name", "tf-acc-disk-snapshots"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataDiskSnapshotsConfig = `
+data "ucloud_zones" "default" {}
+
+resource "ucloud_disk" "foo" {
+	availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+	name              = "tf-acc-disk-snapshots"
+	tag               = "tf-acc"
+	disk_size         = 10
+}
+
+resource "ucloud_disk_snapshot" "foo" {
+	disk_id = "${ucloud_disk.foo.id}"
+	name    = "tf-acc-disk-snapshots"
+}
+
+data "ucloud_disk_snapshots" "foo" {
+	availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+	ids               = ["${ucloud_disk_snapshot.foo.id}"]
+}
+`
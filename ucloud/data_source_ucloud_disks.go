@@ -0,0 +1,266 @@
+package ucloud
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/udisk"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func dataSourceUCloudDisks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudDisksRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"disk_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"data_disk", "ssd_data_disk", "system_disk", "ssd_system_disk"}, false),
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Available", "InUse"}, false),
+			},
+
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"disks": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"availability_zone": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"disk_size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"disk_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"charge_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"data_ark": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"tag": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"instance_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"instance_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"instance_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"device_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"expire_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudDisksRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).udiskconn
+
+	req := conn.NewDescribeUDiskRequest()
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		req.Zone = ucloud.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disk_type"); ok {
+		req.DiskType = ucloud.String(diskTypeCvt.convert(v.(string)))
+	}
+
+	// the api only supports to filter by one disk id, the others are filtered at here
+	ids, idsOk := d.GetOk("ids")
+	if idsOk && ids.(*schema.Set).Len() == 1 {
+		req.UDiskId = ucloud.String(schemaSetToStringSlice(ids)[0])
+	}
+
+	var allDisks []udisk.UDiskDataSet
+	var limit int = 100
+	var offset int
+	for {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeUDisk(req)
+		if err != nil {
+			return fmt.Errorf("error on reading disk list, %s", err)
+		}
+
+		if resp == nil || len(resp.DataSet) < 1 {
+			break
+		}
+
+		allDisks = append(allDisks, resp.DataSet...)
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	status, statusOk := d.GetOk("status")
+	instanceId, instanceIdOk := d.GetOk("instance_id")
+
+	var disks []udisk.UDiskDataSet
+	for _, item := range allDisks {
+		if idsOk && !isStringIn(item.UDiskId, schemaSetToStringSlice(ids)) {
+			continue
+		}
+
+		if nameRegexOk {
+			r := regexp.MustCompile(nameRegex.(string))
+			if !r.MatchString(item.Name) {
+				continue
+			}
+		}
+
+		if statusOk && item.Status != status.(string) {
+			continue
+		}
+
+		if instanceIdOk && item.UHostId != instanceId.(string) {
+			continue
+		}
+
+		disks = append(disks, item)
+	}
+
+	d.Set("total_count", len(disks))
+	err := dataSourceUCloudDisksSave(d, disks)
+	if err != nil {
+		return fmt.Errorf("error on reading disk list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudDisksSave(d *schema.ResourceData, disks []udisk.UDiskDataSet) error {
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, item := range disks {
+		ids = append(ids, item.UDiskId)
+		data = append(data, map[string]interface{}{
+			"id":                item.UDiskId,
+			"availability_zone": item.Zone,
+			"name":              item.Name,
+			"disk_size":         item.Size,
+			"disk_type":         diskTypeCvt.unconvert(item.DiskType),
+			"charge_type":       upperCamelCvt.convert(item.ChargeType),
+			"data_ark":          boolCamelCvt.unconvert(item.UDataArkMode),
+			"tag":               item.Tag,
+			"status":            item.Status,
+			"instance_id":       item.UHostId,
+			"instance_name":     item.UHostName,
+			"instance_ip":       item.UHostIP,
+			"device_name":       item.DeviceName,
+			"create_time":       timestampToString(item.CreateTime),
+			"expire_time":       timestampToString(item.ExpiredTime),
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("disks", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudDisksDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataDisksConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_disks.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_disks.foo", "disks.#", "2"),
+					resource.TestCheckResourceAttr("data.ucloud_disks.foo", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.ucloud_disks.foo", "disks.0.disk_size", "10"),
+					resource.TestCheckResourceAttr("data.ucloud_disks.foo", "disks.0.disk_type", "data_disk"),
+					resource.TestCheckResourceAttr("data.ucloud_disks.foo", "disks.0.charge_type", "month"),
+					resource.TestCheckResourceAttr("data.ucloud_disks.bar", "disks.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_disks.bar", "disks.0.status", "InUse"),
+					resource.TestCheckResourceAttrPair("data.ucloud_disks.bar", "disks.0.instance_id", "ucloud_instance.foo", "id"),
+					resource.TestCheckResourceAttr("data.ucloud_disks.baz", "disks.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_disks.baz", "disks.0.status", "Available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataDisksConfig(rInt int) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_disk" "foo" {
+  count             = 2
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name              = "tf-acc-disks-%d"
+  disk_size         = 10
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-disks-%d"
+  tag               = "tf-acc"
+}
+
+resource "ucloud_disk_attachment" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  disk_id           = "${ucloud_disk.foo.0.id}"
+  instance_id       = "${ucloud_instance.foo.id}"
+}
+
+data "ucloud_disks" "foo" {
+  ids               = ["${ucloud_disk.foo.*.id}"]
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  disk_type         = "data_disk"
+  name_regex        = "^tf-acc-disks-%d$"
+}
+
+data "ucloud_disks" "bar" {
+  ids         = ["${ucloud_disk.foo.*.id}"]
+  instance_id = "${ucloud_disk_attachment.foo.instance_id}"
+}
+
+data "ucloud_disks" "baz" {
+  ids    = ["${ucloud_disk.foo.*.id}", "${ucloud_disk_attachment.foo.disk_id}"]
+  status = "Available"
+}
+`, rInt, rInt, rInt)
+}
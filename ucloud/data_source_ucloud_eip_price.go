@@ -0,0 +1,115 @@
+package ucloud
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func dataSourceUCloudEIPPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudEIPPriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"bandwidth": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 800),
+			},
+
+			"internet_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"bgp",
+					"international",
+				}, false),
+			},
+
+			"charge_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "month",
+				ValidateFunc: validation.StringInSlice([]string{
+					"month",
+					"year",
+					"dynamic",
+				}, false),
+			},
+
+			"charge_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Default:  "bandwidth",
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"traffic",
+					"bandwidth",
+				}, false),
+			},
+
+			"duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 9),
+			},
+
+			"price": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceUCloudEIPPriceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).unetconn
+
+	chargeType := upperCamelCvt.unconvert(d.Get("charge_type").(string))
+
+	req := conn.NewGetEIPPriceRequest()
+	req.Bandwidth = ucloud.Int(d.Get("bandwidth").(int))
+	req.ChargeType = ucloud.String(chargeType)
+	req.PayMode = ucloud.String(upperCamelCvt.unconvert(d.Get("charge_mode").(string)))
+	req.OperatorName = ucloud.String(upperCamelCvt.unconvert(d.Get("internet_type").(string)))
+
+	resp, err := conn.GetEIPPrice(req)
+	if err != nil {
+		return fmt.Errorf("error on reading eip price, %s", err)
+	}
+
+	var price *unet.EIPPriceDetailSet
+	for i := range resp.PriceSet {
+		if resp.PriceSet[i].ChargeType == chargeType {
+			price = &resp.PriceSet[i]
+			break
+		}
+	}
+
+	if price == nil {
+		return fmt.Errorf("error on reading eip price, the price of charge type %s is not found", chargeType)
+	}
+
+	// the price of eip is returned for one unit of charge type,
+	// so the price of prepaid eip is multiplied by duration as the same as instance and disk.
+	total := price.Price
+	if chargeType != "Dynamic" {
+		total = price.Price * float64(d.Get("duration").(int))
+	}
+
+	d.SetId(hashStringArray([]string{
+		d.Get("internet_type").(string),
+		strconv.Itoa(d.Get("bandwidth").(int)),
+		d.Get("charge_mode").(string),
+		chargeType,
+		strconv.Itoa(d.Get("duration").(int)),
+	}))
+	d.Set("price", total)
+
+	return nil
+}
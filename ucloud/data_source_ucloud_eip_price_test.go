@@ -0,0 +1,37 @@
+package ucloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudEIPPriceDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataEIPPriceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_eip_price.foo"),
+					testAccCheckPriceIsPositive("data.ucloud_eip_price.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_eip_price.foo", "bandwidth", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataEIPPriceConfig = `
+data "ucloud_eip_price" "foo" {
+	internet_type = "bgp"
+	bandwidth     = 2
+	charge_mode   = "bandwidth"
+	charge_type   = "dynamic"
+}
+`
@@ -7,6 +7,8 @@ import (
 )
 
 func TestAccUCloudEipsDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
@@ -27,7 +29,7 @@ func TestAccUCloudEipsDataSource_basic(t *testing.T) {
 const testAccDataEipsConfig = `
 resource "ucloud_eip" "foo" {
 	count         = 2
-	name          = "tf-test-acc-eip"
+	name          = "tf-acc-eips"
 	bandwidth     = 1
 	internet_type = "bgp"
 	duration      = 1
@@ -0,0 +1,169 @@
+package ucloud
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/uhost"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func dataSourceUCloudInstancePrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudInstancePriceRead,
+
+		Schema: map[string]*schema.Schema{
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"instance_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateInstanceType,
+			},
+
+			"charge_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "month",
+				ValidateFunc: validation.StringInSlice([]string{
+					"year",
+					"month",
+					"dynamic",
+				}, false),
+			},
+
+			"duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateDuration,
+			},
+
+			"boot_disk_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(20, 100),
+			},
+
+			"boot_disk_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "local_normal",
+				ValidateFunc: validation.StringInSlice([]string{"local_normal", "local_ssd", "cloud_normal", "cloud_ssd"}, false),
+			},
+
+			"data_disk_size": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 2000),
+			},
+
+			"data_disk_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "local_normal",
+				ValidateFunc: validation.StringInSlice([]string{"local_normal", "local_ssd"}, false),
+			},
+
+			"backup_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none",
+				ValidateFunc: validation.StringInSlice([]string{"none", "data_ark"}, false),
+			},
+
+			"price": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceUCloudInstancePriceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.uhostconn
+
+	imageId := d.Get("image_id").(string)
+	bootDiskType := d.Get("boot_disk_type").(string)
+	chargeType := upperCamelCvt.unconvert(d.Get("charge_type").(string))
+
+	req := conn.NewGetUHostInstancePriceRequest()
+	req.Zone = ucloud.String(d.Get("availability_zone").(string))
+	req.ImageId = ucloud.String(imageId)
+	req.ChargeType = ucloud.String(chargeType)
+	req.Quantity = ucloud.Int(d.Get("duration").(int))
+	req.Count = ucloud.Int(1)
+
+	// skip error because it has been validated by schema
+	t, _ := parseInstanceType(d.Get("instance_type").(string))
+	req.CPU = ucloud.Int(t.CPU)
+	req.Memory = ucloud.Int(t.Memory)
+
+	// the boot disk is the same as creating instance, the default size is the size of image
+	imageResp, err := client.DescribeImageById(imageId)
+	if err != nil {
+		return fmt.Errorf("error on reading image %s when reading instance price, %s", imageId, err)
+	}
+
+	bootDisk := uhost.UHostDisk{}
+	bootDisk.IsBoot = ucloud.String("True")
+	bootDisk.Size = ucloud.Int(imageResp.ImageSize)
+	bootDisk.Type = ucloud.String(upperCvt.unconvert(bootDiskType))
+	if v, ok := d.GetOk("boot_disk_size"); ok && (bootDiskType == "cloud_normal" || bootDiskType == "cloud_ssd") {
+		if v.(int) < imageResp.ImageSize {
+			return fmt.Errorf("expected boot_disk_size to be at least %d", imageResp.ImageSize)
+		}
+		bootDisk.Size = ucloud.Int(v.(int))
+	}
+	req.Disks = append(req.Disks, bootDisk)
+
+	if v, ok := d.GetOk("data_disk_size"); ok {
+		dataDisk := uhost.UHostDisk{}
+		dataDisk.IsBoot = ucloud.String("False")
+		dataDisk.Type = ucloud.String(upperCvt.unconvert(d.Get("data_disk_type").(string)))
+		dataDisk.Size = ucloud.Int(v.(int))
+
+		req.Disks = append(req.Disks, dataDisk)
+	}
+
+	req.TimemachineFeature = ucloud.String(boolCamelCvt.convert(d.Get("backup_mode").(string) == "data_ark"))
+
+	resp, err := conn.GetUHostInstancePrice(req)
+	if err != nil {
+		return fmt.Errorf("error on reading instance price, %s", err)
+	}
+
+	var price *uhost.UHostPriceSet
+	for i := range resp.PriceSet {
+		if resp.PriceSet[i].ChargeType == chargeType {
+			price = &resp.PriceSet[i]
+			break
+		}
+	}
+
+	if price == nil {
+		return fmt.Errorf("error on reading instance price, the price of charge type %s is not found", chargeType)
+	}
+
+	d.SetId(hashStringArray([]string{
+		d.Get("availability_zone").(string),
+		imageId,
+		d.Get("instance_type").(string),
+		chargeType,
+		strconv.Itoa(d.Get("duration").(int)),
+	}))
+	d.Set("price", price.Price)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package ucloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccUCloudInstancePriceDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataInstancePriceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_instance_price.foo"),
+					testAccCheckPriceIsPositive("data.ucloud_instance_price.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_instance_price.foo", "charge_type", "month"),
+					resource.TestCheckResourceAttr("data.ucloud_instance_price.foo", "duration", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPriceIsPositive(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("data source not found: %s", n)
+		}
+
+		price, err := strconv.ParseFloat(rs.Primary.Attributes["price"], 64)
+		if err != nil {
+			return fmt.Errorf("price is not a number, %s", err)
+		}
+
+		if price <= 0 {
+			return fmt.Errorf("price expected to be positive, got %v", price)
+		}
+		return nil
+	}
+}
+
+const testAccDataInstancePriceConfig = `
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+data "ucloud_instance_price" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  instance_type     = "n-basic-1"
+  charge_type       = "month"
+  duration          = 2
+  data_disk_size    = 20
+}
+`
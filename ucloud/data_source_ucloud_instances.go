@@ -0,0 +1,366 @@
+package ucloud
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/uhost"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func dataSourceUCloudInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tag": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTag,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"instances": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"availability_zone": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"charge_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"backup_mode": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"remark": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tag": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"vpc_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"cpu": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"memory": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"disk_set": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"size": &schema.Schema{
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"id": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"is_boot": &schema.Schema{
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"ip_set": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"internet_type": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"expire_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"auto_renew": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).uhostconn
+
+	req := conn.NewDescribeUHostInstanceRequest()
+
+	if ids, ok := d.GetOk("ids"); ok {
+		req.UHostIds = schemaSetToStringSlice(ids)
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		req.Zone = ucloud.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tag"); ok {
+		req.Tag = ucloud.String(v.(string))
+	}
+
+	var allInstances []uhost.UHostInstanceSet
+	var limit int = 100
+	var offset int
+	for {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeUHostInstance(req)
+		if err != nil {
+			return fmt.Errorf("error on reading instance list, %s", err)
+		}
+
+		if resp == nil || len(resp.UHostSet) < 1 {
+			break
+		}
+
+		allInstances = append(allInstances, resp.UHostSet...)
+
+		if len(resp.UHostSet) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	vpcId, vpcIdOk := d.GetOk("vpc_id")
+	subnetId, subnetIdOk := d.GetOk("subnet_id")
+	status, statusOk := d.GetOk("status")
+
+	var instances []uhost.UHostInstanceSet
+	for _, item := range allInstances {
+		if nameRegexOk {
+			r := regexp.MustCompile(nameRegex.(string))
+			if !r.MatchString(item.Name) {
+				continue
+			}
+		}
+
+		if statusOk && strings.Replace(item.State, " ", "", -1) != status.(string) {
+			continue
+		}
+
+		privateIP := getInstancePrivateIP(item)
+		if vpcIdOk && (privateIP == nil || privateIP.VPCId != vpcId.(string)) {
+			continue
+		}
+
+		if subnetIdOk && (privateIP == nil || privateIP.SubnetId != subnetId.(string)) {
+			continue
+		}
+
+		instances = append(instances, item)
+	}
+
+	d.Set("total_count", len(instances))
+	err := dataSourceUCloudInstancesSave(d, instances)
+	if err != nil {
+		return fmt.Errorf("error on reading instance list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudInstancesSave(d *schema.ResourceData, instances []uhost.UHostInstanceSet) error {
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, instance := range instances {
+		ids = append(ids, instance.UHostId)
+
+		ipSet := []map[string]interface{}{}
+		for _, item := range instance.IPSet {
+			ipSet = append(ipSet, map[string]interface{}{
+				"ip":            item.IP,
+				"internet_type": item.Type,
+			})
+		}
+
+		diskSet := []map[string]interface{}{}
+		for _, item := range instance.DiskSet {
+			diskSet = append(diskSet, map[string]interface{}{
+				"type":    upperCvt.convert(item.DiskType),
+				"size":    item.Size,
+				"id":      item.DiskId,
+				"is_boot": boolValueCvt.unconvert(item.IsBoot),
+			})
+		}
+
+		backupMode := "none"
+		if strings.ToLower(instance.TimemachineFeature) == "yes" {
+			backupMode = "data_ark"
+		}
+
+		var vpcId, subnetId, privateIP string
+		if ip := getInstancePrivateIP(instance); ip != nil {
+			vpcId = ip.VPCId
+			subnetId = ip.SubnetId
+			privateIP = ip.IP
+		}
+
+		data = append(data, map[string]interface{}{
+			"id":                instance.UHostId,
+			"availability_zone": instance.Zone,
+			"name":              instance.Name,
+			"charge_type":       upperCamelCvt.convert(instance.ChargeType),
+			"backup_mode":       backupMode,
+			"remark":            instance.Remark,
+			"tag":               instance.Tag,
+			"vpc_id":            vpcId,
+			"subnet_id":         subnetId,
+			"private_ip":        privateIP,
+			"cpu":               instance.CPU,
+			"memory":            instance.Memory,
+			"status":            strings.Replace(instance.State, " ", "", -1),
+			"disk_set":          diskSet,
+			"ip_set":            ipSet,
+			"create_time":       timestampToString(instance.CreateTime),
+			"expire_time":       timestampToString(instance.ExpireTime),
+			"auto_renew":        boolCamelCvt.unconvert(instance.AutoRenew),
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("instances", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
+
+// getInstancePrivateIP will returns the private ip of instance, which contains the vpc and subnet of instance
+func getInstancePrivateIP(instance uhost.UHostInstanceSet) *uhost.UHostIPSet {
+	for _, item := range instance.IPSet {
+		if item.Type == "Private" {
+			return &item
+		}
+	}
+	return nil
+}
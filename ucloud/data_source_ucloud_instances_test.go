@@ -0,0 +1,88 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudInstancesDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataInstancesConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_instances.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_instances.foo", "instances.#", "2"),
+					resource.TestCheckResourceAttr("data.ucloud_instances.foo", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.ucloud_instances.foo", "instances.0.cpu", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_instances.foo", "instances.0.memory", "1024"),
+					resource.TestCheckResourceAttr("data.ucloud_instances.foo", "instances.0.status", "Running"),
+					resource.TestCheckResourceAttrSet("data.ucloud_instances.foo", "instances.0.private_ip"),
+					resource.TestCheckResourceAttrSet("data.ucloud_instances.foo", "instances.0.disk_set.#"),
+					resource.TestCheckResourceAttr("data.ucloud_instances.bar", "instances.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataInstancesConfig(rInt int) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_vpc" "default" {
+  name        = "tf-acc-instances-%d"
+  tag         = "tf-acc"
+  cidr_blocks = ["192.168.0.0/16"]
+}
+
+resource "ucloud_subnet" "default" {
+  name       = "tf-acc-instances-%d"
+  tag        = "tf-acc"
+  cidr_block = "192.168.1.0/24"
+  vpc_id     = "${ucloud_vpc.default.id}"
+}
+
+resource "ucloud_instance" "foo" {
+  count             = 2
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-instances-%d"
+  tag               = "tf-acc"
+  vpc_id            = "${ucloud_vpc.default.id}"
+  subnet_id         = "${ucloud_subnet.default.id}"
+}
+
+data "ucloud_instances" "foo" {
+  ids        = ["${ucloud_instance.foo.*.id}"]
+  name_regex = "^tf-acc-instances-%d$"
+  vpc_id     = "${ucloud_vpc.default.id}"
+  subnet_id  = "${ucloud_subnet.default.id}"
+  status     = "Running"
+}
+
+data "ucloud_instances" "bar" {
+  ids    = ["${ucloud_instance.foo.*.id}"]
+  status = "Stopped"
+}
+`, rInt, rInt, rInt, rInt)
+}
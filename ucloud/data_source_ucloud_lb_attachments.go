@@ -0,0 +1,143 @@
+package ucloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ucloud/ucloud-sdk-go/services/ulb"
+)
+
+func dataSourceUCloudLBAttachments() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudLBAttachmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"lb_attachments": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"private_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudLBAttachmentsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	lbId := d.Get("load_balancer_id").(string)
+	listenerId := d.Get("listener_id").(string)
+
+	// the backends of listener are returned within the listener
+	vserverSet, err := client.describeVServerById(lbId, listenerId)
+	if err != nil {
+		return fmt.Errorf("error on reading lb attachment list, %s", err)
+	}
+
+	ids, idsOk := d.GetOk("ids")
+
+	var lbAttachments []ulb.ULBBackendSet
+	for _, item := range vserverSet.BackendSet {
+		if idsOk && !isStringIn(item.BackendId, schemaSetToStringSlice(ids)) {
+			continue
+		}
+
+		lbAttachments = append(lbAttachments, item)
+	}
+
+	d.Set("total_count", len(lbAttachments))
+	err = dataSourceUCloudLBAttachmentsSave(d, lbAttachments)
+	if err != nil {
+		return fmt.Errorf("error on reading lb attachment list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudLBAttachmentsSave(d *schema.ResourceData, lbAttachments []ulb.ULBBackendSet) error {
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, item := range lbAttachments {
+		ids = append(ids, item.BackendId)
+		data = append(data, map[string]interface{}{
+			"id":            item.BackendId,
+			"resource_type": titleCaseProdCvt.unconvert(item.ResourceType),
+			"resource_id":   item.ResourceId,
+			"port":          item.Port,
+			"private_ip":    item.PrivateIP,
+			"status":        lbAttachmentStatusCvt.convert(item.Status),
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("lb_attachments", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
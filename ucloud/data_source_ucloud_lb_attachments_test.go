@@ -0,0 +1,83 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudLBAttachmentsDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLBAttachmentsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_lb_attachments.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_attachments.foo", "lb_attachments.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_attachments.foo", "total_count", "1"),
+					resource.TestCheckResourceAttrPair("data.ucloud_lb_attachments.foo", "lb_attachments.0.id", "ucloud_lb_attachment.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.ucloud_lb_attachments.foo", "lb_attachments.0.resource_id", "ucloud_instance.foo", "id"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_attachments.foo", "lb_attachments.0.resource_type", "instance"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_attachments.foo", "lb_attachments.0.port", "80"),
+					resource.TestCheckResourceAttrSet("data.ucloud_lb_attachments.foo", "lb_attachments.0.private_ip"),
+					resource.TestCheckResourceAttrSet("data.ucloud_lb_attachments.foo", "lb_attachments.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataLBAttachmentsConfig(rInt int) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_lb" "foo" {
+  name = "tf-acc-lb-attachments-%d"
+  tag  = "tf-acc"
+}
+
+resource "ucloud_lb_listener" "foo" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  protocol         = "http"
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-lb-attachments-%d"
+  tag               = "tf-acc"
+}
+
+resource "ucloud_lb_attachment" "foo" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  listener_id      = "${ucloud_lb_listener.foo.id}"
+  resource_type    = "instance"
+  resource_id      = "${ucloud_instance.foo.id}"
+  port             = 80
+}
+
+data "ucloud_lb_attachments" "foo" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  listener_id      = "${ucloud_lb_attachment.foo.listener_id}"
+  ids              = ["${ucloud_lb_attachment.foo.id}"]
+}
+`, rInt, rInt)
+}
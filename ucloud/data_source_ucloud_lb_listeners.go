@@ -0,0 +1,226 @@
+package ucloud
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/ulb"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+	uerr "github.com/ucloud/ucloud-sdk-go/ucloud/error"
+)
+
+func dataSourceUCloudLBListeners() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudLBListenersRead,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"lb_listeners": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"protocol": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"listen_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"idle_timeout": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"method": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"persistence_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"persistence": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"health_check_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"domain": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudLBListenersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).ulbconn
+
+	req := conn.NewDescribeVServerRequest()
+	req.ULBId = ucloud.String(d.Get("load_balancer_id").(string))
+
+	// the api only supports to filter by one listener id, the others are filtered at here
+	ids, idsOk := d.GetOk("ids")
+	if idsOk && ids.(*schema.Set).Len() == 1 {
+		req.VServerId = ucloud.String(schemaSetToStringSlice(ids)[0])
+	}
+
+	var allLBListeners []ulb.ULBVServerSet
+	var limit int = 100
+	var offset int
+	for {
+		req.Limit = ucloud.String(strconv.Itoa(limit))
+		req.Offset = ucloud.String(strconv.Itoa(offset))
+		resp, err := conn.DescribeVServer(req)
+		if err != nil {
+			// the listener filtered by id is not found, it is the same as the empty list
+			if uErr, ok := err.(uerr.Error); ok && req.VServerId != nil && uErr.Code() == 4103 {
+				break
+			}
+			return fmt.Errorf("error on reading lb listener list, %s", err)
+		}
+
+		if resp == nil || len(resp.DataSet) < 1 {
+			break
+		}
+
+		allLBListeners = append(allLBListeners, resp.DataSet...)
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
+	var lbListeners []ulb.ULBVServerSet
+	for _, item := range allLBListeners {
+		if idsOk && !isStringIn(item.VServerId, schemaSetToStringSlice(ids)) {
+			continue
+		}
+
+		if nameRegexOk {
+			r := regexp.MustCompile(nameRegex.(string))
+			if !r.MatchString(item.VServerName) {
+				continue
+			}
+		}
+
+		lbListeners = append(lbListeners, item)
+	}
+
+	d.Set("total_count", len(lbListeners))
+	err := dataSourceUCloudLBListenersSave(d, lbListeners)
+	if err != nil {
+		return fmt.Errorf("error on reading lb listener list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudLBListenersSave(d *schema.ResourceData, lbListeners []ulb.ULBVServerSet) error {
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, item := range lbListeners {
+		ids = append(ids, item.VServerId)
+		data = append(data, map[string]interface{}{
+			"id":                item.VServerId,
+			"name":              item.VServerName,
+			"protocol":          upperCvt.convert(item.Protocol),
+			"listen_type":       upperCamelCvt.convert(item.ListenType),
+			"port":              item.FrontendPort,
+			"idle_timeout":      item.ClientTimeout,
+			"method":            upperCamelCvt.convert(item.Method),
+			"persistence_type":  upperCamelCvt.convert(item.PersistenceType),
+			"persistence":       item.PersistenceInfo,
+			"health_check_type": upperCamelCvt.convert(item.MonitorType),
+			"domain":            item.Domain,
+			"path":              item.Path,
+			"status":            listenerStatusCvt.convert(item.Status),
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("lb_listeners", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudLBListenersDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLBListenersConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_lb_listeners.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_listeners.foo", "lb_listeners.#", "2"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_listeners.foo", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_listeners.bar", "lb_listeners.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_listeners.bar", "lb_listeners.0.name", fmt.Sprintf("tf-acc-lb-listeners-%d-http", rInt)),
+					resource.TestCheckResourceAttr("data.ucloud_lb_listeners.bar", "lb_listeners.0.protocol", "http"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_listeners.bar", "lb_listeners.0.port", "80"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_listeners.bar", "lb_listeners.0.listen_type", "request_proxy"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_listeners.notfound", "lb_listeners.#", "0"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_listeners.notfound", "total_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataLBListenersConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "ucloud_lb" "foo" {
+  name = "tf-acc-lb-listeners-%d"
+  tag  = "tf-acc"
+}
+
+resource "ucloud_lb_listener" "http" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  name             = "tf-acc-lb-listeners-%d-http"
+  protocol         = "http"
+  port             = 80
+}
+
+resource "ucloud_lb_listener" "tcp" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  name             = "tf-acc-lb-listeners-%d-tcp"
+  protocol         = "tcp"
+  port             = 8080
+}
+
+data "ucloud_lb_listeners" "foo" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  ids              = ["${ucloud_lb_listener.http.id}", "${ucloud_lb_listener.tcp.id}"]
+}
+
+data "ucloud_lb_listeners" "bar" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  ids              = ["${ucloud_lb_listener.http.id}", "${ucloud_lb_listener.tcp.id}"]
+  name_regex       = "-http$"
+}
+
+data "ucloud_lb_listeners" "notfound" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  ids              = ["vserver-notexists"]
+}
+`, rInt, rInt, rInt)
+}
@@ -0,0 +1,147 @@
+package ucloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ucloud/ucloud-sdk-go/services/ulb"
+)
+
+func dataSourceUCloudLBRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudLBRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"lb_rules": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"backend_ids": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"domain": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudLBRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	lbId := d.Get("load_balancer_id").(string)
+	listenerId := d.Get("listener_id").(string)
+
+	// the content forwarding policies of listener are returned within the listener
+	vserverSet, err := client.describeVServerById(lbId, listenerId)
+	if err != nil {
+		return fmt.Errorf("error on reading lb rule list, %s", err)
+	}
+
+	ids, idsOk := d.GetOk("ids")
+
+	var lbRules []ulb.ULBPolicySet
+	for _, item := range vserverSet.PolicySet {
+		if idsOk && !isStringIn(item.PolicyId, schemaSetToStringSlice(ids)) {
+			continue
+		}
+
+		lbRules = append(lbRules, item)
+	}
+
+	d.Set("total_count", len(lbRules))
+	err = dataSourceUCloudLBRulesSave(d, lbRules)
+	if err != nil {
+		return fmt.Errorf("error on reading lb rule list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudLBRulesSave(d *schema.ResourceData, lbRules []ulb.ULBPolicySet) error {
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, item := range lbRules {
+		ids = append(ids, item.PolicyId)
+
+		backendIds := []string{}
+		for _, backend := range item.BackendSet {
+			backendIds = append(backendIds, backend.BackendId)
+		}
+
+		var domain, path string
+		if item.Type == "Domain" {
+			domain = item.Match
+		}
+
+		if item.Type == "Path" {
+			path = item.Match
+		}
+
+		data = append(data, map[string]interface{}{
+			"id":          item.PolicyId,
+			"backend_ids": backendIds,
+			"domain":      domain,
+			"path":        path,
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("lb_rules", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudLBRulesDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLBRulesConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_lb_rules.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_rules.foo", "lb_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_rules.foo", "total_count", "1"),
+					resource.TestCheckResourceAttrPair("data.ucloud_lb_rules.foo", "lb_rules.0.id", "ucloud_lb_rule.foo", "id"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_rules.foo", "lb_rules.0.domain", "www.ucloud.cn"),
+					resource.TestCheckResourceAttr("data.ucloud_lb_rules.foo", "lb_rules.0.path", ""),
+					resource.TestCheckResourceAttr("data.ucloud_lb_rules.foo", "lb_rules.0.backend_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ucloud_lb_rules.foo", "lb_rules.0.backend_ids.0", "ucloud_lb_attachment.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataLBRulesConfig(rInt int) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_lb" "foo" {
+  name = "tf-acc-lb-rules-%d"
+  tag  = "tf-acc"
+}
+
+resource "ucloud_lb_listener" "foo" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  protocol         = "http"
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-lb-rules-%d"
+  tag               = "tf-acc"
+}
+
+resource "ucloud_lb_attachment" "foo" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  listener_id      = "${ucloud_lb_listener.foo.id}"
+  resource_type    = "instance"
+  resource_id      = "${ucloud_instance.foo.id}"
+  port             = 80
+}
+
+resource "ucloud_lb_rule" "foo" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  listener_id      = "${ucloud_lb_listener.foo.id}"
+  backend_ids      = ["${ucloud_lb_attachment.foo.id}"]
+  domain           = "www.ucloud.cn"
+}
+
+data "ucloud_lb_rules" "foo" {
+  load_balancer_id = "${ucloud_lb.foo.id}"
+  listener_id      = "${ucloud_lb_rule.foo.listener_id}"
+  ids              = ["${ucloud_lb_rule.foo.id}"]
+}
+`, rInt, rInt)
+}
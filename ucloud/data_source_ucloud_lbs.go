@@ -0,0 +1,268 @@
+package ucloud
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/ulb"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+	uerr "github.com/ucloud/ucloud-sdk-go/ucloud/error"
+)
+
+func dataSourceUCloudLBs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudLBsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"tag": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTag,
+			},
+
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"internal": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"lbs": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tag": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"remark": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"internal": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"vpc_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ip_set": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"internet_type": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"expire_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudLBsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).ulbconn
+
+	req := conn.NewDescribeULBRequest()
+
+	// the api only supports to filter by one lb id, the others are filtered at here
+	ids, idsOk := d.GetOk("ids")
+	if idsOk && ids.(*schema.Set).Len() == 1 {
+		req.ULBId = ucloud.String(schemaSetToStringSlice(ids)[0])
+	}
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		req.VPCId = ucloud.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		req.SubnetId = ucloud.String(v.(string))
+	}
+
+	var allLBs []ulb.ULBSet
+	var limit int = 100
+	var offset int
+	for {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeULB(req)
+		if err != nil {
+			// the lb filtered by id is not found, it is the same as the empty list
+			if uErr, ok := err.(uerr.Error); ok && req.ULBId != nil && (uErr.Code() == 4103 || uErr.Code() == 4086) {
+				break
+			}
+			return fmt.Errorf("error on reading lb list, %s", err)
+		}
+
+		if resp == nil || len(resp.DataSet) < 1 {
+			break
+		}
+
+		allLBs = append(allLBs, resp.DataSet...)
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	tag, tagOk := d.GetOk("tag")
+	internal, internalOk := d.GetOkExists("internal")
+
+	var lbs []ulb.ULBSet
+	for _, item := range allLBs {
+		if idsOk && !isStringIn(item.ULBId, schemaSetToStringSlice(ids)) {
+			continue
+		}
+
+		if nameRegexOk {
+			r := regexp.MustCompile(nameRegex.(string))
+			if !r.MatchString(item.Name) {
+				continue
+			}
+		}
+
+		if tagOk && item.Tag != tag.(string) {
+			continue
+		}
+
+		if internalOk && (item.ULBType == "InnerMode") != internal.(bool) {
+			continue
+		}
+
+		lbs = append(lbs, item)
+	}
+
+	d.Set("total_count", len(lbs))
+	err := dataSourceUCloudLBsSave(d, lbs)
+	if err != nil {
+		return fmt.Errorf("error on reading lb list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudLBsSave(d *schema.ResourceData, lbs []ulb.ULBSet) error {
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, lb := range lbs {
+		ids = append(ids, lb.ULBId)
+
+		ipSet := []map[string]interface{}{}
+		for _, item := range lb.IPSet {
+			ipSet = append(ipSet, map[string]interface{}{
+				"ip":            item.EIP,
+				"internet_type": item.OperatorName,
+			})
+		}
+
+		data = append(data, map[string]interface{}{
+			"id":          lb.ULBId,
+			"name":        lb.Name,
+			"tag":         lb.Tag,
+			"remark":      lb.Remark,
+			"internal":    lb.ULBType == "InnerMode",
+			"vpc_id":      lb.VPCId,
+			"subnet_id":   lb.SubnetId,
+			"private_ip":  lb.PrivateIP,
+			"ip_set":      ipSet,
+			"create_time": timestampToString(lb.CreateTime),
+			"expire_time": timestampToString(lb.ExpireTime),
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("lbs", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudLBsDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataLBsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_lbs.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_lbs.foo", "lbs.#", "2"),
+					resource.TestCheckResourceAttr("data.ucloud_lbs.foo", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.ucloud_lbs.bar", "lbs.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_lbs.bar", "lbs.0.name", fmt.Sprintf("tf-acc-lbs-%d-inner", rInt)),
+					resource.TestCheckResourceAttr("data.ucloud_lbs.bar", "lbs.0.internal", "true"),
+					resource.TestCheckResourceAttr("data.ucloud_lbs.bar", "lbs.0.tag", "tf-acc"),
+					resource.TestCheckResourceAttrPair("data.ucloud_lbs.bar", "lbs.0.vpc_id", "ucloud_vpc.default", "id"),
+					resource.TestCheckResourceAttrSet("data.ucloud_lbs.bar", "lbs.0.create_time"),
+					resource.TestCheckResourceAttr("data.ucloud_lbs.baz", "lbs.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_lbs.baz", "lbs.0.internal", "false"),
+					resource.TestCheckResourceAttr("data.ucloud_lbs.notfound", "lbs.#", "0"),
+					resource.TestCheckResourceAttr("data.ucloud_lbs.notfound", "total_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataLBsConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "ucloud_vpc" "default" {
+  name        = "tf-acc-lbs-%d"
+  tag         = "tf-acc"
+  cidr_blocks = ["192.168.0.0/16"]
+}
+
+resource "ucloud_subnet" "default" {
+  name       = "tf-acc-lbs-%d"
+  tag        = "tf-acc"
+  cidr_block = "192.168.1.0/24"
+  vpc_id     = "${ucloud_vpc.default.id}"
+}
+
+resource "ucloud_lb" "inner" {
+  name      = "tf-acc-lbs-%d-inner"
+  tag       = "tf-acc"
+  internal  = true
+  vpc_id    = "${ucloud_vpc.default.id}"
+  subnet_id = "${ucloud_subnet.default.id}"
+}
+
+resource "ucloud_lb" "outer" {
+  name      = "tf-acc-lbs-%d-outer"
+  tag       = "tf-acc"
+  vpc_id    = "${ucloud_vpc.default.id}"
+  subnet_id = "${ucloud_subnet.default.id}"
+}
+
+data "ucloud_lbs" "foo" {
+  ids        = ["${ucloud_lb.inner.id}", "${ucloud_lb.outer.id}"]
+  name_regex = "^tf-acc-lbs-%d"
+  tag        = "tf-acc"
+  vpc_id     = "${ucloud_vpc.default.id}"
+}
+
+data "ucloud_lbs" "bar" {
+  ids      = ["${ucloud_lb.inner.id}", "${ucloud_lb.outer.id}"]
+  internal = true
+}
+
+data "ucloud_lbs" "baz" {
+  ids      = ["${ucloud_lb.inner.id}", "${ucloud_lb.outer.id}"]
+  internal = false
+}
+
+data "ucloud_lbs" "notfound" {
+  ids = ["ulb-notexists"]
+}
+`, rInt, rInt, rInt, rInt, rInt)
+}
@@ -8,6 +8,8 @@ import (
 )
 
 func TestAccUCloudProjectsDataSource(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
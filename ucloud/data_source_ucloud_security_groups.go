@@ -0,0 +1,239 @@
+package ucloud
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+	uerr "github.com/ucloud/ucloud-sdk-go/ucloud/error"
+)
+
+func dataSourceUCloudSecurityGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudSecurityGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"tag": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTag,
+			},
+
+			"resource_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tag": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"remark": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"rules": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"port_range": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"protocol": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"cidr_block": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"policy": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"priority": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).unetconn
+
+	req := conn.NewDescribeFirewallRequest()
+
+	// the api only supports to filter by one security group id, the others are filtered at here
+	ids, idsOk := d.GetOk("ids")
+	if idsOk && ids.(*schema.Set).Len() == 1 {
+		req.FWId = ucloud.String(schemaSetToStringSlice(ids)[0])
+	}
+
+	if v, ok := d.GetOk("resource_id"); ok {
+		req.ResourceId = ucloud.String(v.(string))
+	}
+
+	var allSecurityGroups []unet.FirewallDataSet
+	var limit int = 100
+	var offset int
+	for {
+		req.Limit = ucloud.String(strconv.Itoa(limit))
+		req.Offset = ucloud.String(strconv.Itoa(offset))
+		resp, err := conn.DescribeFirewall(req)
+		if err != nil {
+			// the security group filtered by id is not found, it is the same as the empty list
+			if uErr, ok := err.(uerr.Error); ok && req.FWId != nil && uErr.Code() == 54002 {
+				break
+			}
+			return fmt.Errorf("error on reading security group list, %s", err)
+		}
+
+		if resp == nil || len(resp.DataSet) < 1 {
+			break
+		}
+
+		allSecurityGroups = append(allSecurityGroups, resp.DataSet...)
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+	tag, tagOk := d.GetOk("tag")
+
+	var securityGroups []unet.FirewallDataSet
+	for _, item := range allSecurityGroups {
+		if idsOk && !isStringIn(item.FWId, schemaSetToStringSlice(ids)) {
+			continue
+		}
+
+		if nameRegexOk {
+			r := regexp.MustCompile(nameRegex.(string))
+			if !r.MatchString(item.Name) {
+				continue
+			}
+		}
+
+		if tagOk && item.Tag != tag.(string) {
+			continue
+		}
+
+		securityGroups = append(securityGroups, item)
+	}
+
+	d.Set("total_count", len(securityGroups))
+	err := dataSourceUCloudSecurityGroupsSave(d, securityGroups)
+	if err != nil {
+		return fmt.Errorf("error on reading security group list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudSecurityGroupsSave(d *schema.ResourceData, securityGroups []unet.FirewallDataSet) error {
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, item := range securityGroups {
+		ids = append(ids, item.FWId)
+		data = append(data, map[string]interface{}{
+			"id":             item.FWId,
+			"name":           item.Name,
+			"tag":            item.Tag,
+			"remark":         item.Remark,
+			"type":           strings.Replace(strings.ToLower(item.Type), " ", "_", -1),
+			"resource_count": item.ResourceCount,
+			"rules":          flattenRuleSet(item.Rule),
+			"create_time":    timestampToString(item.CreateTime),
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("security_groups", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudSecurityGroupsDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSecurityGroupsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_security_groups.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.foo", "security_groups.#", "2"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.foo", "total_count", "2"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.bar", "security_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.bar", "security_groups.0.name", fmt.Sprintf("tf-acc-security-groups-%d-a", rInt)),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.bar", "security_groups.0.resource_count", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.bar", "security_groups.0.rules.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.bar", "security_groups.0.rules.0.port_range", "80"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.bar", "security_groups.0.rules.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.bar", "security_groups.0.rules.0.cidr_block", "192.168.0.0/16"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.bar", "security_groups.0.rules.0.policy", "accept"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.bar", "security_groups.0.rules.0.priority", "low"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.notfound", "security_groups.#", "0"),
+					resource.TestCheckResourceAttr("data.ucloud_security_groups.notfound", "total_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSecurityGroupsConfig(rInt int) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_security_group" "foo" {
+  name = "tf-acc-security-groups-%d-a"
+  tag  = "tf-acc"
+
+  rules {
+    port_range = "80"
+    protocol   = "tcp"
+    cidr_block = "192.168.0.0/16"
+    policy     = "accept"
+    priority   = "low"
+  }
+}
+
+resource "ucloud_security_group" "bar" {
+  name = "tf-acc-security-groups-%d-b"
+  tag  = "tf-acc"
+
+  rules {
+    port_range = "22"
+    protocol   = "tcp"
+    cidr_block = "0.0.0.0/0"
+    policy     = "drop"
+    priority   = "high"
+  }
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-security-groups-%d"
+  tag               = "tf-acc"
+  security_group    = "${ucloud_security_group.foo.id}"
+}
+
+data "ucloud_security_groups" "foo" {
+  ids        = ["${ucloud_security_group.foo.id}", "${ucloud_security_group.bar.id}"]
+  name_regex = "^tf-acc-security-groups-%d"
+  tag        = "tf-acc"
+}
+
+data "ucloud_security_groups" "bar" {
+  resource_id = "${ucloud_instance.foo.id}"
+}
+
+data "ucloud_security_groups" "notfound" {
+  ids = ["firewall-notexists"]
+}
+`, rInt, rInt, rInt, rInt)
+}
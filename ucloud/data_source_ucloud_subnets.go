@@ -0,0 +1,258 @@
+package ucloud
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/vpc"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+// subnetReservedIPCount is the number of ip addresses reserved by each subnet,
+// such as the network address, the gateway and the broadcast address.
+const subnetReservedIPCount = 3
+
+func dataSourceUCloudSubnets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudSubnetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"tag": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTag,
+			},
+
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"cidr_block": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.CIDRNetwork(0, 32),
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"subnets": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tag": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"remark": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"cidr_block": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"vpc_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"vpc_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"gateway": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"available_ip_count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudSubnetsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).vpcconn
+
+	req := conn.NewDescribeSubnetRequest()
+
+	if ids, ok := d.GetOk("ids"); ok {
+		req.SubnetIds = schemaSetToStringSlice(ids)
+	}
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		req.VPCId = ucloud.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tag"); ok {
+		req.Tag = ucloud.String(v.(string))
+	}
+
+	var allSubnets []vpc.VPCSubnetInfoSet
+	var limit int = 100
+	var offset int
+	for {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeSubnet(req)
+		if err != nil {
+			return fmt.Errorf("error on reading subnet list, %s", err)
+		}
+
+		if resp == nil || len(resp.DataSet) < 1 {
+			break
+		}
+
+		allSubnets = append(allSubnets, resp.DataSet...)
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
+	var cidr *cidrBlock
+	if v, ok := d.GetOk("cidr_block"); ok {
+		// skip error because it has been validated by schema
+		cidr, _ = parseCidrBlock(v.(string))
+	}
+
+	var subnets []vpc.VPCSubnetInfoSet
+	for _, item := range allSubnets {
+		if nameRegexOk {
+			r := regexp.MustCompile(nameRegex.(string))
+			if !r.MatchString(item.SubnetName) {
+				continue
+			}
+		}
+
+		if cidr != nil {
+			block, err := parseCidrBlock(fmt.Sprintf("%s/%s", item.Subnet, item.Netmask))
+			if err != nil || !block.ContainsCidr(cidr) {
+				continue
+			}
+		}
+
+		subnets = append(subnets, item)
+	}
+
+	d.Set("total_count", len(subnets))
+	err := dataSourceUCloudSubnetsSave(d, meta, subnets)
+	if err != nil {
+		return fmt.Errorf("error on reading subnet list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudSubnetsSave(d *schema.ResourceData, meta interface{}, subnets []vpc.VPCSubnetInfoSet) error {
+	client := meta.(*UCloudClient)
+
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, item := range subnets {
+		ids = append(ids, item.SubnetId)
+
+		cidr, err := parseCidrBlock(fmt.Sprintf("%s/%s", item.Subnet, item.Netmask))
+		if err != nil {
+			return err
+		}
+
+		usedIPs, err := client.describeSubnetUsedIPs(item.SubnetId)
+		if err != nil {
+			return err
+		}
+
+		// the resource without ip address in subnet does not take any ip address, such as the load balancer of internet
+		usedCount := 0
+		for _, ip := range usedIPs {
+			if cidr.Contains(ip) {
+				usedCount++
+			}
+		}
+
+		availableCount := cidr.IPCount() - subnetReservedIPCount - usedCount
+		if availableCount < 0 {
+			availableCount = 0
+		}
+
+		data = append(data, map[string]interface{}{
+			"id":                 item.SubnetId,
+			"name":               item.SubnetName,
+			"tag":                item.Tag,
+			"remark":             item.Remark,
+			"cidr_block":         cidr.String(),
+			"vpc_id":             item.VPCId,
+			"vpc_name":           item.VPCName,
+			"gateway":            item.Gateway,
+			"available_ip_count": availableCount,
+			"create_time":        timestampToString(item.CreateTime),
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("subnets", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
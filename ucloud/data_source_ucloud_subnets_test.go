@@ -0,0 +1,73 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudSubnetsDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSubnetsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_subnets.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_subnets.foo", "subnets.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_subnets.foo", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_subnets.foo", "subnets.0.name", fmt.Sprintf("tf-acc-subnets-%d-a", rInt)),
+					resource.TestCheckResourceAttr("data.ucloud_subnets.foo", "subnets.0.cidr_block", "192.168.1.0/24"),
+					resource.TestCheckResourceAttr("data.ucloud_subnets.foo", "subnets.0.available_ip_count", "253"),
+					resource.TestCheckResourceAttrSet("data.ucloud_subnets.foo", "subnets.0.create_time"),
+					resource.TestCheckResourceAttr("data.ucloud_subnets.bar", "subnets.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSubnetsConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "ucloud_vpc" "default" {
+  name        = "tf-acc-subnets-%d"
+  tag         = "tf-acc"
+  cidr_blocks = ["192.168.0.0/16"]
+}
+
+resource "ucloud_subnet" "foo" {
+  name       = "tf-acc-subnets-%d-a"
+  tag        = "tf-acc"
+  cidr_block = "192.168.1.0/24"
+  vpc_id     = "${ucloud_vpc.default.id}"
+}
+
+resource "ucloud_subnet" "bar" {
+  name       = "tf-acc-subnets-%d-b"
+  tag        = "tf-acc"
+  cidr_block = "192.168.2.0/24"
+  vpc_id     = "${ucloud_vpc.default.id}"
+}
+
+data "ucloud_subnets" "foo" {
+  ids        = ["${ucloud_subnet.foo.id}", "${ucloud_subnet.bar.id}"]
+  name_regex = "^tf-acc-subnets-%d"
+  cidr_block = "192.168.1.10/32"
+}
+
+data "ucloud_subnets" "bar" {
+  ids    = ["${ucloud_subnet.foo.id}", "${ucloud_subnet.bar.id}"]
+  vpc_id = "${ucloud_vpc.default.id}"
+  tag    = "tf-acc"
+}
+`, rInt, rInt, rInt, rInt)
+}
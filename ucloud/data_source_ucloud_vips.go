@@ -0,0 +1,171 @@
+package ucloud
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func dataSourceUCloudVIPs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudVIPsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"vips": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"vpc_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudVIPsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).unetconn
+
+	req := conn.NewDescribeVIPRequest()
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		req.VPCId = ucloud.String(v.(string))
+	}
+
+	// the api only supports to filter by subnet within the vpc
+	if v, ok := d.GetOk("subnet_id"); ok {
+		if req.VPCId == nil {
+			return fmt.Errorf("error on reading vip list, vpc_id is required when subnet_id is set")
+		}
+		req.SubnetId = ucloud.String(v.(string))
+	}
+
+	resp, err := conn.DescribeVIP(req)
+	if err != nil {
+		return fmt.Errorf("error on reading vip list, %s", err)
+	}
+
+	ids, idsOk := d.GetOk("ids")
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
+	var vips []unet.VIPDetailSet
+	for _, item := range resp.VIPSet {
+		if idsOk && !isStringIn(item.VIPId, schemaSetToStringSlice(ids)) {
+			continue
+		}
+
+		if nameRegexOk {
+			r := regexp.MustCompile(nameRegex.(string))
+			if !r.MatchString(item.Name) {
+				continue
+			}
+		}
+
+		vips = append(vips, item)
+	}
+
+	d.Set("total_count", len(vips))
+	err = dataSourceUCloudVIPsSave(d, vips)
+	if err != nil {
+		return fmt.Errorf("error on reading vip list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudVIPsSave(d *schema.ResourceData, vips []unet.VIPDetailSet) error {
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, item := range vips {
+		ids = append(ids, item.VIPId)
+		data = append(data, map[string]interface{}{
+			"id":          item.VIPId,
+			"name":        item.Name,
+			"vpc_id":      item.VPCId,
+			"subnet_id":   item.SubnetId,
+			"ip":          item.VIP,
+			"create_time": timestampToString(item.CreateTime),
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("vips", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package ucloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudVIPsDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataVIPsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_vips.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_vips.foo", "vips.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataVIPsConfig = `
+resource "ucloud_vpc" "foo" {
+	name        = "tf-acc-vips"
+	tag         = "tf-acc"
+	cidr_blocks = ["192.168.0.0/16"]
+}
+
+resource "ucloud_subnet" "foo" {
+	name       = "tf-acc-vips"
+	tag        = "tf-acc"
+	cidr_block = "192.168.1.0/24"
+	vpc_id     = "${ucloud_vpc.foo.id}"
+}
+
+resource "ucloud_vip" "foo" {
+	count     = 2
+	vpc_id    = "${ucloud_vpc.foo.id}"
+	subnet_id = "${ucloud_subnet.foo.id}"
+	name      = "tf-acc-vips"
+}
+
+data "ucloud_vips" "foo" {
+	vpc_id = "${ucloud_vpc.foo.id}"
+	ids    = ["${ucloud_vip.foo.*.id}"]
+}
+`
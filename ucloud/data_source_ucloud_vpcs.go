@@ -0,0 +1,224 @@
+package ucloud
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/vpc"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func dataSourceUCloudVPCs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceUCloudVPCsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"tag": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTag,
+			},
+
+			"cidr_block": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.CIDRNetwork(0, 32),
+			},
+
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"total_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"vpcs": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tag": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"cidr_blocks": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"network_info": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cidr_block": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"subnet_count": &schema.Schema{
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"subnet_count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"create_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"update_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUCloudVPCsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*UCloudClient).vpcconn
+
+	req := conn.NewDescribeVPCRequest()
+
+	if ids, ok := d.GetOk("ids"); ok {
+		req.VPCIds = schemaSetToStringSlice(ids)
+	}
+
+	if v, ok := d.GetOk("tag"); ok {
+		req.Tag = ucloud.String(v.(string))
+	}
+
+	// the api of vpc is not paginated, all of the vpc is returned at once
+	resp, err := conn.DescribeVPC(req)
+	if err != nil {
+		return fmt.Errorf("error on reading vpc list, %s", err)
+	}
+
+	nameRegex, nameRegexOk := d.GetOk("name_regex")
+
+	var cidr *cidrBlock
+	if v, ok := d.GetOk("cidr_block"); ok {
+		// skip error because it has been validated by schema
+		cidr, _ = parseCidrBlock(v.(string))
+	}
+
+	var vpcs []vpc.VPCInfo
+	for _, item := range resp.DataSet {
+		if nameRegexOk {
+			r := regexp.MustCompile(nameRegex.(string))
+			if !r.MatchString(item.Name) {
+				continue
+			}
+		}
+
+		if cidr != nil && !isVPCContainsCidr(item, cidr) {
+			continue
+		}
+
+		vpcs = append(vpcs, item)
+	}
+
+	d.Set("total_count", len(vpcs))
+	err = dataSourceUCloudVPCsSave(d, vpcs)
+	if err != nil {
+		return fmt.Errorf("error on reading vpc list, %s", err)
+	}
+
+	return nil
+}
+
+func dataSourceUCloudVPCsSave(d *schema.ResourceData, vpcs []vpc.VPCInfo) error {
+	ids := []string{}
+	data := []map[string]interface{}{}
+
+	for _, item := range vpcs {
+		ids = append(ids, item.VPCId)
+
+		networkInfo := []map[string]interface{}{}
+		for _, network := range item.NetworkInfo {
+			networkInfo = append(networkInfo, map[string]interface{}{
+				"cidr_block":   network.Network,
+				"subnet_count": network.SubnetCount,
+			})
+		}
+
+		data = append(data, map[string]interface{}{
+			"id":           item.VPCId,
+			"name":         item.Name,
+			"tag":          item.Tag,
+			"cidr_blocks":  item.Network,
+			"network_info": networkInfo,
+			"subnet_count": item.SubnetCount,
+			"create_time":  timestampToString(item.CreateTime),
+			"update_time":  timestampToString(item.UpdateTime),
+		})
+	}
+
+	d.SetId(hashStringArray(ids))
+	if err := d.Set("vpcs", data); err != nil {
+		return err
+	}
+
+	if outputFile, ok := d.GetOk("output_file"); ok && outputFile.(string) != "" {
+		writeToFile(outputFile.(string), data)
+	}
+
+	return nil
+}
+
+// isVPCContainsCidr will check if the cidr block is included by any of the network of vpc
+func isVPCContainsCidr(vpcSet vpc.VPCInfo, cidr *cidrBlock) bool {
+	for _, network := range vpcSet.Network {
+		block, err := parseCidrBlock(network)
+		if err != nil {
+			continue
+		}
+
+		if block.ContainsCidr(cidr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package ucloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudVPCsDataSource_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataVPCsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDExists("data.ucloud_vpcs.foo"),
+					resource.TestCheckResourceAttr("data.ucloud_vpcs.foo", "vpcs.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_vpcs.foo", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_vpcs.foo", "vpcs.0.name", fmt.Sprintf("tf-acc-vpcs-%d-a", rInt)),
+					resource.TestCheckResourceAttr("data.ucloud_vpcs.foo", "vpcs.0.tag", "tf-acc"),
+					resource.TestCheckResourceAttr("data.ucloud_vpcs.foo", "vpcs.0.network_info.#", "1"),
+					resource.TestCheckResourceAttr("data.ucloud_vpcs.foo", "vpcs.0.network_info.0.cidr_block", "192.168.0.0/16"),
+					resource.TestCheckResourceAttrSet("data.ucloud_vpcs.foo", "vpcs.0.create_time"),
+					resource.TestCheckResourceAttr("data.ucloud_vpcs.bar", "vpcs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataVPCsConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "ucloud_vpc" "foo" {
+  name        = "tf-acc-vpcs-%d-a"
+  tag         = "tf-acc"
+  cidr_blocks = ["192.168.0.0/16"]
+}
+
+resource "ucloud_vpc" "bar" {
+  name        = "tf-acc-vpcs-%d-b"
+  tag         = "tf-acc"
+  cidr_blocks = ["172.16.0.0/16"]
+}
+
+data "ucloud_vpcs" "foo" {
+  ids        = ["${ucloud_vpc.foo.id}", "${ucloud_vpc.bar.id}"]
+  name_regex = "^tf-acc-vpcs-%d"
+  cidr_block = "192.168.1.0/24"
+}
+
+data "ucloud_vpcs" "bar" {
+  ids        = ["${ucloud_vpc.foo.id}", "${ucloud_vpc.bar.id}"]
+  name_regex = "^tf-acc-vpcs-%d"
+  tag        = "tf-acc"
+}
+`, rInt, rInt, rInt, rInt)
+}
@@ -7,6 +7,8 @@ import (
 )
 
 func TestAccUCloudZonesDataSource(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
@@ -21,3 +21,24 @@ func GetEndpointURL(region string) string {
 func GetInsecureEndpointURL(region string) string {
 	return publicInsecureEndpoint.GetURL()
 }
+
+// products is the list of product which endpoint can be customized
+var products = []string{"uhost", "unet", "ulb", "vpc", "udisk", "uaccount"}
+
+// getEndpointURL will return endpoint url string by product,
+// the endpoint of product takes precedence over base url, and then the public endpoint.
+func (c *Config) getEndpointURL(product string) string {
+	if v, ok := c.Endpoints[product]; ok && v != "" {
+		return v
+	}
+
+	if c.BaseUrl != "" {
+		return c.BaseUrl
+	}
+
+	// set endpoint with insecure https connection
+	if c.Insecure {
+		return GetEndpointURL(c.Region)
+	}
+	return GetInsecureEndpointURL(c.Region)
+}
@@ -0,0 +1,52 @@
+package ucloud
+
+import (
+	"testing"
+)
+
+func TestConfig_getEndpointURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		product string
+		want    string
+	}{
+		{
+			"ok_default",
+			Config{Region: "cn-bj2"},
+			"uhost",
+			GetInsecureEndpointURL("cn-bj2"),
+		},
+		{
+			"ok_insecure",
+			Config{Region: "cn-bj2", Insecure: true},
+			"uhost",
+			GetEndpointURL("cn-bj2"),
+		},
+		{
+			"ok_base_url",
+			Config{Region: "cn-bj2", BaseUrl: "https://api.example.com"},
+			"uhost",
+			"https://api.example.com",
+		},
+		{
+			"ok_product_endpoint",
+			Config{Region: "cn-bj2", BaseUrl: "https://api.example.com", Endpoints: map[string]string{"unet": "http://127.0.0.1:8080"}},
+			"unet",
+			"http://127.0.0.1:8080",
+		},
+		{
+			"ok_other_product_endpoint",
+			Config{Region: "cn-bj2", BaseUrl: "https://api.example.com", Endpoints: map[string]string{"unet": "http://127.0.0.1:8080"}},
+			"ulb",
+			"https://api.example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.getEndpointURL(tt.product); got != tt.want {
+				t.Errorf("getEndpointURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
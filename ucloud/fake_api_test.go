@@ -0,0 +1,402 @@
+package ucloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ucloud/ucloud-sdk-go/services/uhost"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+// fakeAPIRegion is the region of fake api, all of resources are created in its zones
+const fakeAPIRegion = "cn-bj2"
+
+// fakeAPIZones is the availability zones of fake api region
+var fakeAPIZones = []string{"cn-bj2-02", "cn-bj2-03"}
+
+// fakeAPIProjectId is the project of fake api
+const fakeAPIProjectId = "org-fake"
+
+// fakeAPINotSupportedCode is the RetCode returned when the action is not implemented by fake api
+const fakeAPINotSupportedCode = 160
+
+var fakeAPIOnce sync.Once
+
+// startFakeAPIIfEnabled will start the fake api server and point the provider to it by environment variables,
+// if UCLOUD_FAKE_API is set, then acceptance tests can run without a real account.
+func startFakeAPIIfEnabled() {
+	if os.Getenv("UCLOUD_FAKE_API") == "" {
+		return
+	}
+
+	fakeAPIOnce.Do(func() {
+		server := httptest.NewServer(newFakeUCloudAPI())
+
+		os.Setenv("UCLOUD_BASE_URL", server.URL)
+		os.Setenv("UCLOUD_PUBLIC_KEY", "fake-public-key")
+		os.Setenv("UCLOUD_PRIVATE_KEY", "fake-private-key")
+		os.Setenv("UCLOUD_REGION", fakeAPIRegion)
+		os.Setenv("UCLOUD_PROJECT_ID", fakeAPIProjectId)
+	})
+}
+
+// fakeAction is the handler of an api action, it returns the response struct of sdk or an api error
+type fakeAction func(q fakeQuery) (interface{}, error)
+
+// fakeAPIError is the error with RetCode returned by fake api
+type fakeAPIError struct {
+	RetCode int
+	Message string
+}
+
+func (e *fakeAPIError) Error() string {
+	return fmt.Sprintf("%d: %s", e.RetCode, e.Message)
+}
+
+func newFakeAPIError(code int, format string, args ...interface{}) error {
+	return &fakeAPIError{RetCode: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// fakeQuery is the query parameters of an api request
+type fakeQuery struct {
+	url.Values
+}
+
+func (q fakeQuery) str(key string) string {
+	return q.Get(key)
+}
+
+func (q fakeQuery) strOr(key, defaultValue string) string {
+	if v := q.Get(key); v != "" {
+		return v
+	}
+	return defaultValue
+}
+
+func (q fakeQuery) int(key string) int {
+	v, _ := strconv.Atoi(q.Get(key))
+	return v
+}
+
+func (q fakeQuery) intOr(key string, defaultValue int) int {
+	if q.Get(key) == "" {
+		return defaultValue
+	}
+	return q.int(key)
+}
+
+// list will returns the values of list parameter, such as UHostIds.0, UHostIds.1, etc.
+func (q fakeQuery) list(key string) []string {
+	values := []string{}
+	for i := 0; ; i++ {
+		v, ok := q.Values[fmt.Sprintf("%s.%d", key, i)]
+		if !ok || len(v) < 1 {
+			break
+		}
+		values = append(values, v[0])
+	}
+	return values
+}
+
+// page will returns the range of the current page by Offset and Limit
+func (q fakeQuery) page(total int) (int, int) {
+	offset := q.intOr("Offset", 0)
+	limit := q.intOr("Limit", 20)
+
+	if offset > total {
+		offset = total
+	}
+
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+
+	return offset, end
+}
+
+// fakePrice is the price of one charge type returned by fake api
+type fakePrice struct {
+	ChargeType string
+	Price      float64
+}
+
+// prices will returns the price of each charge type by the hourly price, the prepaid price is multiplied by Quantity,
+// all of charge types are returned if ChargeType is not specified.
+func (q fakeQuery) prices(hourly float64) []fakePrice {
+	chargeTypes := []string{"Year", "Month", "Dynamic"}
+	if v := q.str("ChargeType"); v != "" {
+		chargeTypes = []string{v}
+	}
+
+	quantity := float64(q.intOr("Quantity", 1))
+	prices := []fakePrice{}
+	for _, chargeType := range chargeTypes {
+		switch chargeType {
+		case "Year":
+			prices = append(prices, fakePrice{chargeType, hourly * 6000 * quantity})
+		case "Month":
+			prices = append(prices, fakePrice{chargeType, hourly * 600 * quantity})
+		default:
+			prices = append(prices, fakePrice{chargeType, hourly})
+		}
+	}
+	return prices
+}
+
+// fakeUCloudAPI is an in-process fake of UCloud API, which is backed by in-memory state.
+// Each product registers its actions and keeps its own state in the fake.
+type fakeUCloudAPI struct {
+	mu      sync.Mutex
+	seq     int
+	actions map[string]fakeAction
+
+	uaccount *fakeUAccountState
+	uhost    *fakeUHostState
+	unet     *fakeUNetState
+	vpc      *fakeVPCState
+	udisk    *fakeUDiskState
+	ulb      *fakeULBState
+}
+
+func newFakeUCloudAPI() *fakeUCloudAPI {
+	api := &fakeUCloudAPI{actions: map[string]fakeAction{}}
+
+	api.registerUAccount()
+	api.registerUHost()
+	api.registerUNet()
+	api.registerVPC()
+	api.registerUDisk()
+	api.registerULB()
+
+	return api
+}
+
+func (api *fakeUCloudAPI) register(action string, handler fakeAction) {
+	api.actions[action] = handler
+}
+
+// newId will returns an unique resource id with the prefix
+func (api *fakeUCloudAPI) newId(prefix string) string {
+	api.seq++
+	return fmt.Sprintf("%s-fake%04d", prefix, api.seq)
+}
+
+// now will returns the current unix timestamp
+func (api *fakeUCloudAPI) now() int {
+	return int(time.Now().Unix())
+}
+
+// ServeHTTP implements http.Handler
+func (api *fakeUCloudAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := fakeQuery{r.URL.Query()}
+	action := q.str("Action")
+
+	var body interface{}
+	handler, ok := api.actions[action]
+	if !ok {
+		body = &fakeAPIError{RetCode: fakeAPINotSupportedCode, Message: fmt.Sprintf("action %s is not supported by fake api", action)}
+	} else {
+		api.mu.Lock()
+		resp, err := handler(q)
+		api.mu.Unlock()
+
+		if err != nil {
+			if apiErr, ok := err.(*fakeAPIError); ok {
+				body = apiErr
+			} else {
+				body = &fakeAPIError{RetCode: 230, Message: err.Error()}
+			}
+		} else {
+			body = resp
+		}
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// the action of response is always the action name with Response suffix
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	fields["Action"] = action + "Response"
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(fields)
+}
+
+func newFakeAPITestClient(t *testing.T) (*UCloudClient, *httptest.Server) {
+	server := httptest.NewServer(newFakeUCloudAPI())
+
+	config := Config{
+		PublicKey:  "fake-public-key",
+		PrivateKey: "fake-private-key",
+		Region:     fakeAPIRegion,
+		ProjectId:  fakeAPIProjectId,
+		BaseUrl:    server.URL,
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		server.Close()
+		t.Fatalf("error on creating client, %s", err)
+	}
+	return client, server
+}
+
+func TestFakeUCloudAPI_notSupported(t *testing.T) {
+	server := httptest.NewServer(newFakeUCloudAPI())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/?Action=DescribeFakeNotSupported")
+	if err != nil {
+		t.Fatalf("error on requesting fake api, %s", err)
+	}
+	defer resp.Body.Close()
+
+	var body fakeAPIError
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("error on decoding response, %s", err)
+	}
+
+	if body.RetCode != fakeAPINotSupportedCode {
+		t.Fatalf("expected error with code %d, got %#v", fakeAPINotSupportedCode, body)
+	}
+}
+
+func TestFakeUCloudAPI_lifecycle(t *testing.T) {
+	client, server := newFakeAPITestClient(t)
+	defer server.Close()
+
+	vpcReq := client.vpcconn.NewCreateVPCRequest()
+	vpcReq.Name = ucloud.String("tf-acc-vpc")
+	vpcReq.Network = []string{"192.168.0.0/16"}
+	vpcResp, err := client.vpcconn.CreateVPC(vpcReq)
+	if err != nil {
+		t.Fatalf("error on creating vpc, %s", err)
+	}
+
+	subnetReq := client.vpcconn.NewCreateSubnetRequest()
+	subnetReq.VPCId = ucloud.String(vpcResp.VPCId)
+	subnetReq.Subnet = ucloud.String("192.168.1.0")
+	subnetReq.Netmask = ucloud.Int(24)
+	subnetResp, err := client.vpcconn.CreateSubnet(subnetReq)
+	if err != nil {
+		t.Fatalf("error on creating subnet, %s", err)
+	}
+
+	subnet, err := client.describeSubnetById(subnetResp.SubnetId)
+	if err != nil {
+		t.Fatalf("error on reading subnet, %s", err)
+	}
+
+	if subnet.VPCId != vpcResp.VPCId || subnet.Netmask != "24" {
+		t.Fatalf("unexpected subnet %#v", subnet)
+	}
+
+	hostReq := client.uhostconn.NewCreateUHostInstanceRequest()
+	hostReq.Zone = ucloud.String(fakeAPIZones[0])
+	hostReq.ImageId = ucloud.String("uimage-fake-centos")
+	hostReq.Password = ucloud.String("fake-password")
+	hostReq.VPCId = ucloud.String(vpcResp.VPCId)
+	hostReq.SubnetId = ucloud.String(subnetResp.SubnetId)
+	hostReq.Disks = []uhost.UHostDisk{
+		{IsBoot: ucloud.String("True"), Type: ucloud.String("LOCAL_NORMAL"), Size: ucloud.Int(20)},
+	}
+	hostResp, err := client.uhostconn.CreateUHostInstance(hostReq)
+	if err != nil {
+		t.Fatalf("error on creating instance, %s", err)
+	}
+
+	instanceId := hostResp.UHostIds[0]
+	instance, err := client.describeInstanceById(instanceId)
+	if err != nil {
+		t.Fatalf("error on reading instance, %s", err)
+	}
+
+	if instance.State != "Running" || len(instance.IPSet) != 1 || instance.IPSet[0].IP != "192.168.1.2" {
+		t.Fatalf("unexpected instance %#v", instance)
+	}
+
+	diskReq := client.udiskconn.NewCreateUDiskRequest()
+	diskReq.Zone = ucloud.String(fakeAPIZones[0])
+	diskReq.Name = ucloud.String("tf-acc-disk")
+	diskReq.Size = ucloud.Int(10)
+	diskResp, err := client.udiskconn.CreateUDisk(diskReq)
+	if err != nil {
+		t.Fatalf("error on creating disk, %s", err)
+	}
+
+	diskId := diskResp.UDiskId[0]
+	attachReq := client.udiskconn.NewAttachUDiskRequest()
+	attachReq.Zone = ucloud.String(fakeAPIZones[0])
+	attachReq.UDiskId = ucloud.String(diskId)
+	attachReq.UHostId = ucloud.String(instanceId)
+	if _, err := client.udiskconn.AttachUDisk(attachReq); err != nil {
+		t.Fatalf("error on attaching disk, %s", err)
+	}
+
+	if _, err := client.describeDiskResource(diskId, instanceId); err != nil {
+		t.Fatalf("error on reading disk attachment, %s", err)
+	}
+
+	// terminate the running instance is not allowed
+	terminateReq := client.uhostconn.NewTerminateUHostInstanceRequest()
+	terminateReq.UHostId = ucloud.String(instanceId)
+	if _, err := client.uhostconn.TerminateUHostInstance(terminateReq); err == nil {
+		t.Fatalf("expected error on terminating running instance, got nil")
+	}
+
+	stopReq := client.uhostconn.NewStopUHostInstanceRequest()
+	stopReq.UHostId = ucloud.String(instanceId)
+	if _, err := client.uhostconn.StopUHostInstance(stopReq); err != nil {
+		t.Fatalf("error on stopping instance, %s", err)
+	}
+
+	if _, err := client.uhostconn.TerminateUHostInstance(terminateReq); err != nil {
+		t.Fatalf("error on terminating instance, %s", err)
+	}
+
+	if _, err := client.describeInstanceById(instanceId); !isNotFoundError(err) {
+		t.Fatalf("expected not found error of instance, got %v", err)
+	}
+
+	disk, err := client.describeDiskById(diskId)
+	if err != nil {
+		t.Fatalf("error on reading disk, %s", err)
+	}
+
+	if disk.Status != "Available" || disk.UHostId != "" {
+		t.Fatalf("expected disk is detached after instance terminated, got %#v", disk)
+	}
+}
+
+func TestFakeUCloudAPI_notFound(t *testing.T) {
+	client, server := newFakeAPITestClient(t)
+	defer server.Close()
+
+	if _, err := client.describeFirewallById("firewall-notexists"); !isNotFoundError(err) {
+		t.Fatalf("expected not found error of security group, got %v", err)
+	}
+
+	if _, err := client.describeLBById("ulb-notexists"); !isNotFoundError(err) {
+		t.Fatalf("expected not found error of lb, got %v", err)
+	}
+
+	if _, err := client.describeEIPById("eip-notexists"); !isNotFoundError(err) {
+		t.Fatalf("expected not found error of eip, got %v", err)
+	}
+}
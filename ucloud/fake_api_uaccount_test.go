@@ -0,0 +1,46 @@
+package ucloud
+
+import (
+	"github.com/ucloud/ucloud-sdk-go/services/uaccount"
+)
+
+// fakeUAccountState is the in-memory state of uaccount product
+type fakeUAccountState struct {
+	projects []uaccount.ProjectListInfo
+}
+
+func (api *fakeUCloudAPI) registerUAccount() {
+	api.uaccount = &fakeUAccountState{
+		projects: []uaccount.ProjectListInfo{
+			{
+				ProjectId:   fakeAPIProjectId,
+				ProjectName: "Default",
+				IsDefault:   true,
+				CreateTime:  api.now(),
+			},
+		},
+	}
+
+	api.register("GetRegion", api.getRegion)
+	api.register("GetProjectList", api.getProjectList)
+}
+
+func (api *fakeUCloudAPI) getRegion(q fakeQuery) (interface{}, error) {
+	resp := &uaccount.GetRegionResponse{}
+	for i, zone := range fakeAPIZones {
+		resp.Regions = append(resp.Regions, uaccount.RegionInfo{
+			RegionId:  1000 + i,
+			Region:    fakeAPIRegion,
+			Zone:      zone,
+			IsDefault: i == 0,
+		})
+	}
+	return resp, nil
+}
+
+func (api *fakeUCloudAPI) getProjectList(q fakeQuery) (interface{}, error) {
+	resp := &uaccount.GetProjectListResponse{}
+	resp.ProjectSet = append(resp.ProjectSet, api.uaccount.projects...)
+	resp.ProjectCount = len(resp.ProjectSet)
+	return resp, nil
+}
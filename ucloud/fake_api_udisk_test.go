@@ -0,0 +1,309 @@
+package ucloud
+
+import (
+	"github.com/ucloud/ucloud-sdk-go/services/udisk"
+)
+
+// fakeUDiskState is the in-memory state of udisk product
+type fakeUDiskState struct {
+	disks     map[string]*udisk.UDiskDataSet
+	snapshots map[string]*uDiskSnapshotSet
+}
+
+func (api *fakeUCloudAPI) registerUDisk() {
+	api.udisk = &fakeUDiskState{
+		disks:     map[string]*udisk.UDiskDataSet{},
+		snapshots: map[string]*uDiskSnapshotSet{},
+	}
+
+	api.register("CreateUDisk", api.createUDisk)
+	api.register("DescribeUDisk", api.describeUDisk)
+	api.register("RenameUDisk", api.renameUDisk)
+	api.register("ResizeUDisk", api.resizeUDisk)
+	api.register("SetUDiskUDataArkMode", api.setUDiskUDataArkMode)
+	api.register("DeleteUDisk", api.deleteUDisk)
+	api.register("AttachUDisk", api.attachUDisk)
+	api.register("DetachUDisk", api.detachUDisk)
+	api.register("DescribeUDiskPrice", api.describeUDiskPrice)
+	api.register("CloneUDisk", api.cloneUDisk)
+	api.register("CreateUDiskSnapshot", api.createUDiskSnapshot)
+	api.register("DescribeUDiskSnapshot", api.describeUDiskSnapshot)
+	api.register("DeleteUDiskSnapshot", api.deleteUDiskSnapshot)
+	api.register("CloneUDiskSnapshot", api.cloneUDiskSnapshot)
+}
+
+func (api *fakeUCloudAPI) getUDisk(diskId string) (*udisk.UDiskDataSet, error) {
+	disk, ok := api.udisk.disks[diskId]
+	if !ok {
+		return nil, newFakeAPIError(17060, "udisk %s is not found", diskId)
+	}
+	return disk, nil
+}
+
+func (api *fakeUCloudAPI) createUDisk(q fakeQuery) (interface{}, error) {
+	diskType := q.strOr("DiskType", "DataDisk")
+	if diskType != "DataDisk" && diskType != "SSDDataDisk" {
+		return nil, newFakeAPIError(230, "disk type %s is invalid", diskType)
+	}
+
+	disk := api.newUDisk(q, q.int("Size"), diskType)
+	return &udisk.CreateUDiskResponse{UDiskId: []string{disk.UDiskId}}, nil
+}
+
+// newUDisk will create the available disk by the common parameters of creating and cloning
+func (api *fakeUCloudAPI) newUDisk(q fakeQuery, size int, diskType string) *udisk.UDiskDataSet {
+	disk := &udisk.UDiskDataSet{
+		UDiskId:      api.newId("bsm"),
+		Zone:         q.str("Zone"),
+		Name:         q.str("Name"),
+		Size:         size,
+		Status:       "Available",
+		ChargeType:   q.strOr("ChargeType", "Month"),
+		Tag:          q.strOr("Tag", defaultTag),
+		UDataArkMode: q.strOr("UDataArkMode", "No"),
+		DiskType:     diskType,
+		IsExpire:     "No",
+		CreateTime:   api.now(),
+		ExpiredTime:  api.now() + 30*24*3600,
+	}
+
+	api.udisk.disks[disk.UDiskId] = disk
+	return disk
+}
+
+func (api *fakeUCloudAPI) describeUDisk(q fakeQuery) (interface{}, error) {
+	disks := []udisk.UDiskDataSet{}
+	for _, disk := range api.udisk.disks {
+		if v := q.str("UDiskId"); v != "" && disk.UDiskId != v {
+			continue
+		}
+
+		if v := q.str("Zone"); v != "" && disk.Zone != v {
+			continue
+		}
+
+		if v := q.str("DiskType"); v != "" && disk.DiskType != v {
+			continue
+		}
+
+		disks = append(disks, *disk)
+	}
+
+	start, end := q.page(len(disks))
+	return &udisk.DescribeUDiskResponse{
+		TotalCount: len(disks),
+		DataSet:    disks[start:end],
+	}, nil
+}
+
+func (api *fakeUCloudAPI) renameUDisk(q fakeQuery) (interface{}, error) {
+	disk, err := api.getUDisk(q.str("UDiskId"))
+	if err != nil {
+		return nil, err
+	}
+
+	disk.Name = q.str("UDiskName")
+	return &udisk.RenameUDiskResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) resizeUDisk(q fakeQuery) (interface{}, error) {
+	disk, err := api.getUDisk(q.str("UDiskId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if size := q.int("Size"); size < disk.Size {
+		return nil, newFakeAPIError(17070, "udisk %s can not be shrunk from %d to %d", disk.UDiskId, disk.Size, size)
+	}
+
+	disk.Size = q.int("Size")
+	return &udisk.ResizeUDiskResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) setUDiskUDataArkMode(q fakeQuery) (interface{}, error) {
+	disk, err := api.getUDisk(q.str("UDiskId"))
+	if err != nil {
+		return nil, err
+	}
+
+	disk.UDataArkMode = q.str("UDataArkMode")
+	return &udisk.SetUDiskUDataArkModeResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) deleteUDisk(q fakeQuery) (interface{}, error) {
+	disk, err := api.getUDisk(q.str("UDiskId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if disk.Status == "InUse" {
+		return nil, newFakeAPIError(17071, "udisk %s is attached to uhost %s", disk.UDiskId, disk.UHostId)
+	}
+
+	delete(api.udisk.disks, disk.UDiskId)
+	return &udisk.DeleteUDiskResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) attachUDisk(q fakeQuery) (interface{}, error) {
+	disk, err := api.getUDisk(q.str("UDiskId"))
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := api.getUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if disk.Status == "InUse" {
+		return nil, newFakeAPIError(17072, "udisk %s is already attached to uhost %s", disk.UDiskId, disk.UHostId)
+	}
+
+	disk.Status = "InUse"
+	disk.UHostId = instance.UHostId
+	disk.UHostName = instance.Name
+	disk.DeviceName = "/dev/vdb"
+	api.attachUHostDisk(instance, disk)
+
+	return &udisk.AttachUDiskResponse{UHostId: instance.UHostId, UDiskId: disk.UDiskId}, nil
+}
+
+func (api *fakeUCloudAPI) detachUDisk(q fakeQuery) (interface{}, error) {
+	disk, err := api.getUDisk(q.str("UDiskId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if disk.UHostId != q.str("UHostId") {
+		return nil, newFakeAPIError(17073, "udisk %s is not attached to uhost %s", disk.UDiskId, q.str("UHostId"))
+	}
+
+	if instance, err := api.getUHostInstance(disk.UHostId); err == nil {
+		api.detachUHostDisk(instance, disk.UDiskId)
+	}
+
+	disk.Status = "Available"
+	disk.UHostId = ""
+	disk.UHostName = ""
+	disk.DeviceName = ""
+
+	return &udisk.DetachUDiskResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) cloneUDisk(q fakeQuery) (interface{}, error) {
+	source, err := api.getUDisk(q.str("SourceId"))
+	if err != nil {
+		return nil, err
+	}
+
+	disk := api.newUDisk(q, source.Size, source.DiskType)
+	return &udisk.CloneUDiskResponse{UDiskId: []string{disk.UDiskId}}, nil
+}
+
+func (api *fakeUCloudAPI) getUDiskSnapshot(snapshotId string) (*uDiskSnapshotSet, error) {
+	snapshot, ok := api.udisk.snapshots[snapshotId]
+	if !ok {
+		return nil, newFakeAPIError(17061, "udisk snapshot %s is not found", snapshotId)
+	}
+	return snapshot, nil
+}
+
+func (api *fakeUCloudAPI) createUDiskSnapshot(q fakeQuery) (interface{}, error) {
+	disk, err := api.getUDisk(q.str("UDiskId"))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &uDiskSnapshotSet{
+		SnapshotId:  api.newId("bsm-snapshot"),
+		Name:        q.str("Name"),
+		UDiskId:     disk.UDiskId,
+		UDiskName:   disk.Name,
+		Size:        disk.Size,
+		Comment:     q.str("Comment"),
+		Status:      "Normal",
+		UDiskStatus: disk.Status,
+		IsExpire:    "No",
+		ChargeType:  q.strOr("ChargeType", "Month"),
+		CreateTime:  api.now(),
+		ExpiredTime: api.now() + 30*24*3600,
+	}
+
+	api.udisk.snapshots[snapshot.SnapshotId] = snapshot
+	return &udisk.CreateUDiskSnapshotResponse{SnapshotId: []string{snapshot.SnapshotId}}, nil
+}
+
+// describeUDiskSnapshot will returns the snapshot by SnapshotId, or the snapshots of disk by UDiskId
+func (api *fakeUCloudAPI) describeUDiskSnapshot(q fakeQuery) (interface{}, error) {
+	snapshots := []uDiskSnapshotSet{}
+	for _, snapshot := range api.udisk.snapshots {
+		if v := q.str("SnapshotId"); v != "" && snapshot.SnapshotId != v {
+			continue
+		}
+
+		if v := q.str("UDiskId"); v != "" && q.str("SnapshotId") == "" && snapshot.UDiskId != v {
+			continue
+		}
+
+		snapshots = append(snapshots, *snapshot)
+	}
+
+	start, end := q.page(len(snapshots))
+	return &describeUDiskSnapshotResponse{
+		TotalCount: len(snapshots),
+		DataSet:    snapshots[start:end],
+	}, nil
+}
+
+func (api *fakeUCloudAPI) deleteUDiskSnapshot(q fakeQuery) (interface{}, error) {
+	snapshot, err := api.getUDiskSnapshot(q.str("SnapshotId"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(api.udisk.snapshots, snapshot.SnapshotId)
+	return &deleteUDiskSnapshotResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) cloneUDiskSnapshot(q fakeQuery) (interface{}, error) {
+	snapshot, err := api.getUDiskSnapshot(q.str("SourceId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if size := q.int("Size"); size < snapshot.Size {
+		return nil, newFakeAPIError(17070, "udisk can not be cloned from snapshot %s with size %d smaller than %d", snapshot.SnapshotId, size, snapshot.Size)
+	}
+
+	disk := api.newUDisk(q, q.int("Size"), "DataDisk")
+	return &udisk.CloneUDiskSnapshotResponse{UDiskId: []string{disk.UDiskId}}, nil
+}
+
+// detachUDiskByInstance will detach the data disks of the instance when the instance is terminated
+func (api *fakeUCloudAPI) detachUDiskByInstance(instanceId string) {
+	for _, disk := range api.udisk.disks {
+		if disk.UHostId == instanceId {
+			disk.Status = "Available"
+			disk.UHostId = ""
+			disk.UHostName = ""
+			disk.DeviceName = ""
+		}
+	}
+}
+
+// describeUDiskPrice will returns the price by the size and type of disk
+func (api *fakeUCloudAPI) describeUDiskPrice(q fakeQuery) (interface{}, error) {
+	hourly := 0.001 * float64(q.int("Size"))
+	if q.str("DiskType") == "SSDDataDisk" {
+		hourly *= 3
+	}
+	if q.str("UDataArkMode") == "Yes" {
+		hourly *= 2
+	}
+
+	resp := &udisk.DescribeUDiskPriceResponse{}
+	for _, price := range q.prices(hourly) {
+		resp.DataSet = append(resp.DataSet, udisk.UDiskPriceDataSet{ChargeType: price.ChargeType, Price: price.Price})
+	}
+	return resp, nil
+}
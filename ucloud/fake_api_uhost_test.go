@@ -0,0 +1,521 @@
+package ucloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ucloud/ucloud-sdk-go/services/udisk"
+	"github.com/ucloud/ucloud-sdk-go/services/uhost"
+)
+
+// fakeUHostState is the in-memory state of uhost product
+type fakeUHostState struct {
+	images       []uhost.UHostImageSet
+	customImages map[string]*fakeCustomImage
+	instances    map[string]*uhost.UHostInstanceSet
+}
+
+// fakeCustomImage is the custom image which is only visible in its own region and project
+type fakeCustomImage struct {
+	region    string
+	projectId string
+	image     uhost.UHostImageSet
+}
+
+func (api *fakeUCloudAPI) registerUHost() {
+	api.uhost = &fakeUHostState{
+		images: []uhost.UHostImageSet{
+			{
+				ImageId:   "uimage-fake-centos",
+				ImageName: "CentOS 7.2 64位",
+				OsType:    "Linux",
+				OsName:    "CentOS 7.2 64位",
+				ImageType: "Base",
+				State:     "Available",
+				ImageSize: 20,
+			},
+			{
+				ImageId:   "uimage-fake-centos6",
+				ImageName: "CentOS 6.5 64位",
+				OsType:    "Linux",
+				OsName:    "CentOS 6.5 64位",
+				ImageType: "Base",
+				State:     "Available",
+				ImageSize: 20,
+			},
+		},
+		customImages: map[string]*fakeCustomImage{},
+		instances:    map[string]*uhost.UHostInstanceSet{},
+	}
+
+	api.register("DescribeImage", api.describeImage)
+	api.register("CreateUHostInstance", api.createUHostInstance)
+	api.register("DescribeUHostInstance", api.describeUHostInstance)
+	api.register("StartUHostInstance", api.startUHostInstance)
+	api.register("StopUHostInstance", api.stopUHostInstance)
+	api.register("TerminateUHostInstance", api.terminateUHostInstance)
+	api.register("ModifyUHostInstanceName", api.modifyUHostInstanceName)
+	api.register("ModifyUHostInstanceTag", api.modifyUHostInstanceTag)
+	api.register("ModifyUHostInstanceRemark", api.modifyUHostInstanceRemark)
+	api.register("ResizeUHostInstance", api.resizeUHostInstance)
+	api.register("ReinstallUHostInstance", api.reinstallUHostInstance)
+	api.register("UpgradeToArkUHostInstance", api.upgradeToArkUHostInstance)
+	api.register("ResetUHostInstancePassword", api.resetUHostInstancePassword)
+	api.register("GetUHostInstancePrice", api.getUHostInstancePrice)
+	api.register("CreateCustomImage", api.createCustomImage)
+	api.register("CopyCustomImage", api.copyCustomImage)
+	api.register("TerminateCustomImage", api.terminateCustomImage)
+}
+
+func (api *fakeUCloudAPI) getUHostInstance(instanceId string) (*uhost.UHostInstanceSet, error) {
+	instance, ok := api.uhost.instances[instanceId]
+	if !ok {
+		return nil, newFakeAPIError(8039, "uhost %s is not found", instanceId)
+	}
+	return instance, nil
+}
+
+func (api *fakeUCloudAPI) getStoppedUHostInstance(instanceId string) (*uhost.UHostInstanceSet, error) {
+	instance, err := api.getUHostInstance(instanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if instance.State != "Stopped" {
+		return nil, newFakeAPIError(8013, "uhost %s should be stopped, current state is %s", instanceId, instance.State)
+	}
+	return instance, nil
+}
+
+// getImage will returns the base image or the custom image in the region and project of request
+func (api *fakeUCloudAPI) getImage(q fakeQuery, imageId string) (*uhost.UHostImageSet, error) {
+	for i := range api.uhost.images {
+		if api.uhost.images[i].ImageId == imageId {
+			return &api.uhost.images[i], nil
+		}
+	}
+
+	if custom, ok := api.uhost.customImages[imageId]; ok && custom.inScope(q) {
+		return &custom.image, nil
+	}
+
+	return nil, newFakeAPIError(8040, "image %s is not found", imageId)
+}
+
+// inScope will returns true if the custom image is in the region and project of request
+func (image *fakeCustomImage) inScope(q fakeQuery) bool {
+	return image.region == q.strOr("Region", fakeAPIRegion) && image.projectId == q.strOr("ProjectId", fakeAPIProjectId)
+}
+
+func (api *fakeUCloudAPI) describeImage(q fakeQuery) (interface{}, error) {
+	candidates := []uhost.UHostImageSet{}
+	for _, image := range api.uhost.images {
+		image.Zone = q.strOr("Zone", fakeAPIZones[0])
+		candidates = append(candidates, image)
+	}
+
+	for _, custom := range api.uhost.customImages {
+		if !custom.inScope(q) {
+			continue
+		}
+
+		if v := q.str("Zone"); v != "" && custom.image.Zone != v {
+			continue
+		}
+		candidates = append(candidates, custom.image)
+	}
+
+	images := []uhost.UHostImageSet{}
+	for _, image := range candidates {
+		if v := q.str("ImageId"); v != "" && image.ImageId != v {
+			continue
+		}
+
+		if v := q.str("ImageType"); v != "" && image.ImageType != v {
+			continue
+		}
+
+		if v := q.str("OsType"); v != "" && image.OsType != v {
+			continue
+		}
+
+		images = append(images, image)
+	}
+
+	start, end := q.page(len(images))
+	return &uhost.DescribeImageResponse{
+		TotalCount: len(images),
+		ImageSet:   images[start:end],
+	}, nil
+}
+
+func (api *fakeUCloudAPI) createUHostInstance(q fakeQuery) (interface{}, error) {
+	image, err := api.getImage(q, q.str("ImageId"))
+	if err != nil {
+		return nil, err
+	}
+
+	instance := &uhost.UHostInstanceSet{
+		UHostId:            api.newId("uhost"),
+		Zone:               q.str("Zone"),
+		ImageId:            image.ImageId,
+		OsName:             image.OsName,
+		OsType:             image.OsType,
+		Name:               q.strOr("Name", "UHost"),
+		Tag:                q.strOr("Tag", defaultTag),
+		State:              "Running",
+		ChargeType:         q.strOr("ChargeType", "Month"),
+		CPU:                q.intOr("CPU", 1),
+		Memory:             q.intOr("Memory", 1024),
+		AutoRenew:          "Yes",
+		TimemachineFeature: q.strOr("TimemachineFeature", "No"),
+		BootDiskState:      "Normal",
+		CreateTime:         api.now(),
+		ExpireTime:         api.now() + 30*24*3600,
+	}
+
+	for i := 0; ; i++ {
+		prefix := fmt.Sprintf("Disks.%d.", i)
+		if q.str(prefix+"IsBoot") == "" {
+			break
+		}
+
+		disk := uhost.UHostDiskSet{
+			DiskId:   api.newId("bsi"),
+			Size:     q.int(prefix + "Size"),
+			IsBoot:   q.str(prefix + "IsBoot"),
+			DiskType: q.str(prefix + "Type"),
+			Type:     "Data",
+		}
+
+		if disk.IsBoot == "True" {
+			disk.Type = "Boot"
+		}
+		instance.DiskSet = append(instance.DiskSet, disk)
+	}
+
+	privateIP, err := api.allocateUHostPrivateIP(q)
+	if err != nil {
+		return nil, err
+	}
+	instance.IPSet = append(instance.IPSet, *privateIP)
+
+	if v := q.str("SecurityGroupId"); v != "" {
+		for _, firewall := range api.unet.firewalls {
+			if firewall.GroupId == v {
+				api.grantFirewallToResource(firewall.FWId, "UHost", instance.UHostId)
+			}
+		}
+	} else {
+		api.grantDefaultFirewallToResource("UHost", instance.UHostId)
+	}
+
+	api.uhost.instances[instance.UHostId] = instance
+	return &uhost.CreateUHostInstanceResponse{
+		UHostIds: []string{instance.UHostId},
+		IPs:      []string{privateIP.IP},
+	}, nil
+}
+
+// allocateUHostPrivateIP will allocate the private ip in subnet, the default vpc and subnet is used if not specified
+func (api *fakeUCloudAPI) allocateUHostPrivateIP(q fakeQuery) (*uhost.UHostIPSet, error) {
+	subnetId := q.strOr("SubnetId", fakeAPIDefaultSubnetId)
+	subnet, ok := api.vpc.subnets[subnetId]
+	if !ok {
+		return nil, newFakeAPIError(58005, "subnet %s is not found", subnetId)
+	}
+
+	if v := q.str("VPCId"); v != "" && v != subnet.VPCId {
+		return nil, newFakeAPIError(58006, "subnet %s is not in vpc %s", subnetId, v)
+	}
+
+	ipSet := &uhost.UHostIPSet{
+		Type:     "Private",
+		IPId:     api.newId("ip"),
+		VPCId:    subnet.VPCId,
+		SubnetId: subnet.SubnetId,
+	}
+
+	if v := q.list("PrivateIp"); len(v) > 0 {
+		ipSet.IP = v[0]
+		return ipSet, nil
+	}
+
+	ip, err := api.allocateSubnetIP(subnet)
+	if err != nil {
+		return nil, err
+	}
+	ipSet.IP = ip
+	return ipSet, nil
+}
+
+func (api *fakeUCloudAPI) describeUHostInstance(q fakeQuery) (interface{}, error) {
+	ids := q.list("UHostIds")
+
+	instances := []uhost.UHostInstanceSet{}
+	for _, instance := range api.uhost.instances {
+		if len(ids) > 0 && !isStringIn(instance.UHostId, ids) {
+			continue
+		}
+
+		if v := q.str("Zone"); v != "" && instance.Zone != v {
+			continue
+		}
+
+		if v := q.str("Tag"); v != "" && instance.Tag != v {
+			continue
+		}
+
+		instances = append(instances, *instance)
+	}
+
+	start, end := q.page(len(instances))
+	return &uhost.DescribeUHostInstanceResponse{
+		TotalCount: len(instances),
+		UHostSet:   instances[start:end],
+	}, nil
+}
+
+func (api *fakeUCloudAPI) startUHostInstance(q fakeQuery) (interface{}, error) {
+	instance, err := api.getUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	instance.State = "Running"
+	return &uhost.StartUHostInstanceResponse{UhostId: instance.UHostId}, nil
+}
+
+func (api *fakeUCloudAPI) stopUHostInstance(q fakeQuery) (interface{}, error) {
+	instance, err := api.getUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	instance.State = "Stopped"
+	return &uhost.StopUHostInstanceResponse{UhostId: instance.UHostId}, nil
+}
+
+func (api *fakeUCloudAPI) terminateUHostInstance(q fakeQuery) (interface{}, error) {
+	instance, err := api.getStoppedUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	api.unbindEIPByResource(instance.UHostId)
+	api.detachUDiskByInstance(instance.UHostId)
+	api.revokeFirewallByResource(instance.UHostId)
+	api.releaseBackendByResource(instance.UHostId)
+
+	delete(api.uhost.instances, instance.UHostId)
+	return &uhost.TerminateUHostInstanceResponse{UHostId: instance.UHostId}, nil
+}
+
+func (api *fakeUCloudAPI) modifyUHostInstanceName(q fakeQuery) (interface{}, error) {
+	instance, err := api.getUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	instance.Name = q.str("Name")
+	return &uhost.ModifyUHostInstanceNameResponse{UhostId: instance.UHostId}, nil
+}
+
+func (api *fakeUCloudAPI) modifyUHostInstanceTag(q fakeQuery) (interface{}, error) {
+	instance, err := api.getUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	instance.Tag = q.strOr("Tag", defaultTag)
+	return &uhost.ModifyUHostInstanceTagResponse{UhostId: instance.UHostId}, nil
+}
+
+func (api *fakeUCloudAPI) modifyUHostInstanceRemark(q fakeQuery) (interface{}, error) {
+	instance, err := api.getUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	instance.Remark = q.str("Remark")
+	return &uhost.ModifyUHostInstanceRemarkResponse{UhostId: instance.UHostId}, nil
+}
+
+func (api *fakeUCloudAPI) resizeUHostInstance(q fakeQuery) (interface{}, error) {
+	instance, err := api.getStoppedUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	instance.CPU = q.intOr("CPU", instance.CPU)
+	instance.Memory = q.intOr("Memory", instance.Memory)
+
+	for i := range instance.DiskSet {
+		disk := &instance.DiskSet[i]
+		if disk.IsBoot == "True" {
+			disk.Size = q.intOr("BootDiskSpace", disk.Size)
+		} else if strings.HasPrefix(disk.DiskType, "LOCAL_") {
+			disk.Size = q.intOr("DiskSpace", disk.Size)
+		}
+	}
+
+	return &uhost.ResizeUHostInstanceResponse{UhostId: instance.UHostId}, nil
+}
+
+func (api *fakeUCloudAPI) reinstallUHostInstance(q fakeQuery) (interface{}, error) {
+	instance, err := api.getStoppedUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if v := q.str("ImageId"); v != "" {
+		image, err := api.getImage(q, v)
+		if err != nil {
+			return nil, err
+		}
+
+		instance.ImageId = image.ImageId
+		instance.OsName = image.OsName
+		instance.OsType = image.OsType
+	}
+
+	return &uhost.ReinstallUHostInstanceResponse{UhostId: instance.UHostId}, nil
+}
+
+func (api *fakeUCloudAPI) upgradeToArkUHostInstance(q fakeQuery) (interface{}, error) {
+	ids := q.list("UHostIds")
+	for _, id := range ids {
+		instance, err := api.getStoppedUHostInstance(id)
+		if err != nil {
+			return nil, err
+		}
+		instance.TimemachineFeature = "Yes"
+	}
+
+	return &uhost.UpgradeToArkUHostInstanceResponse{UHostSet: ids}, nil
+}
+
+func (api *fakeUCloudAPI) resetUHostInstancePassword(q fakeQuery) (interface{}, error) {
+	instance, err := api.getStoppedUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &uhost.ResetUHostInstancePasswordResponse{UhostId: instance.UHostId}, nil
+}
+
+// attachUHostDisk will add the udisk to the disk set of instance
+func (api *fakeUCloudAPI) attachUHostDisk(instance *uhost.UHostInstanceSet, disk *udisk.UDiskDataSet) {
+	diskType := "CLOUD_NORMAL"
+	if strings.HasPrefix(disk.DiskType, "SSD") {
+		diskType = "CLOUD_SSD"
+	}
+
+	instance.DiskSet = append(instance.DiskSet, uhost.UHostDiskSet{
+		DiskId:   disk.UDiskId,
+		Name:     disk.Name,
+		Drive:    disk.DeviceName,
+		Size:     disk.Size,
+		IsBoot:   "False",
+		DiskType: diskType,
+		Type:     "Udisk",
+	})
+}
+
+// detachUHostDisk will remove the udisk from the disk set of instance
+func (api *fakeUCloudAPI) detachUHostDisk(instance *uhost.UHostInstanceSet, diskId string) {
+	diskSet := []uhost.UHostDiskSet{}
+	for _, disk := range instance.DiskSet {
+		if disk.DiskId != diskId {
+			diskSet = append(diskSet, disk)
+		}
+	}
+	instance.DiskSet = diskSet
+}
+
+// getUHostInstancePrice will returns the price by cpu, memory and the size of disks
+func (api *fakeUCloudAPI) getUHostInstancePrice(q fakeQuery) (interface{}, error) {
+	hourly := 0.1*float64(q.intOr("CPU", 1)) + 0.05*float64(q.intOr("Memory", 1024)/1024)
+	for i := 0; ; i++ {
+		prefix := fmt.Sprintf("Disks.%d.", i)
+		if q.str(prefix+"IsBoot") == "" {
+			break
+		}
+		hourly += 0.001 * float64(q.int(prefix+"Size"))
+	}
+	hourly *= float64(q.intOr("Count", 1))
+
+	resp := &uhost.GetUHostInstancePriceResponse{}
+	for _, price := range q.prices(hourly) {
+		resp.PriceSet = append(resp.PriceSet, uhost.UHostPriceSet{ChargeType: price.ChargeType, Price: price.Price})
+	}
+	return resp, nil
+}
+
+func (api *fakeUCloudAPI) createCustomImage(q fakeQuery) (interface{}, error) {
+	instance, err := api.getUHostInstance(q.str("UHostId"))
+	if err != nil {
+		return nil, err
+	}
+
+	image := &fakeCustomImage{
+		region:    q.strOr("Region", fakeAPIRegion),
+		projectId: q.strOr("ProjectId", fakeAPIProjectId),
+		image: uhost.UHostImageSet{
+			ImageId:          api.newId("uimage"),
+			ImageName:        q.str("ImageName"),
+			ImageDescription: q.str("ImageDescription"),
+			Zone:             instance.Zone,
+			ImageType:        "Custom",
+			OsType:           instance.OsType,
+			OsName:           instance.OsName,
+			State:            "Available",
+			CreateTime:       api.now(),
+		},
+	}
+
+	for _, disk := range instance.DiskSet {
+		if disk.IsBoot == "True" {
+			image.image.ImageSize = disk.Size
+		}
+	}
+
+	api.uhost.customImages[image.image.ImageId] = image
+	return &uhost.CreateCustomImageResponse{ImageId: image.image.ImageId}, nil
+}
+
+// copyCustomImage will copy the custom image into the target region and project,
+// the copied image is located in the first zone of target region if the region is changed.
+func (api *fakeUCloudAPI) copyCustomImage(q fakeQuery) (interface{}, error) {
+	source, ok := api.uhost.customImages[q.str("SourceImageId")]
+	if !ok || !source.inScope(q) {
+		return nil, newFakeAPIError(8040, "custom image %s is not found", q.str("SourceImageId"))
+	}
+
+	image := &fakeCustomImage{
+		region:    q.strOr("TargetRegion", source.region),
+		projectId: q.str("TargetProjectId"),
+		image:     source.image,
+	}
+
+	image.image.ImageId = api.newId("uimage")
+	image.image.ImageName = q.strOr("TargetImageName", source.image.ImageName)
+	image.image.ImageDescription = q.str("TargetImageDescription")
+	image.image.CreateTime = api.now()
+	if image.region != source.region {
+		image.image.Zone = image.region + "-01"
+	}
+
+	api.uhost.customImages[image.image.ImageId] = image
+	return &uhost.CopyCustomImageResponse{TargetImageId: image.image.ImageId}, nil
+}
+
+func (api *fakeUCloudAPI) terminateCustomImage(q fakeQuery) (interface{}, error) {
+	image, ok := api.uhost.customImages[q.str("ImageId")]
+	if !ok || !image.inScope(q) {
+		return nil, newFakeAPIError(8040, "custom image %s is not found", q.str("ImageId"))
+	}
+
+	delete(api.uhost.customImages, image.image.ImageId)
+	return &uhost.TerminateCustomImageResponse{ImageId: image.image.ImageId}, nil
+}
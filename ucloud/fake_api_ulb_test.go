@@ -0,0 +1,475 @@
+package ucloud
+
+import (
+	"github.com/ucloud/ucloud-sdk-go/services/ulb"
+)
+
+// fakeULBState is the in-memory state of ulb product
+type fakeULBState struct {
+	lbs map[string]*ulb.ULBSet
+}
+
+func (api *fakeUCloudAPI) registerULB() {
+	api.ulb = &fakeULBState{
+		lbs: map[string]*ulb.ULBSet{},
+	}
+
+	api.register("CreateULB", api.createULB)
+	api.register("DescribeULB", api.describeULB)
+	api.register("UpdateULBAttribute", api.updateULBAttribute)
+	api.register("DeleteULB", api.deleteULB)
+	api.register("CreateVServer", api.createVServer)
+	api.register("DescribeVServer", api.describeVServer)
+	api.register("UpdateVServerAttribute", api.updateVServerAttribute)
+	api.register("DeleteVServer", api.deleteVServer)
+	api.register("AllocateBackend", api.allocateBackend)
+	api.register("UpdateBackendAttribute", api.updateBackendAttribute)
+	api.register("ReleaseBackend", api.releaseBackend)
+	api.register("CreatePolicy", api.createPolicy)
+	api.register("UpdatePolicy", api.updatePolicy)
+	api.register("DeletePolicy", api.deletePolicy)
+}
+
+func (api *fakeUCloudAPI) getULB(lbId string) (*ulb.ULBSet, error) {
+	lb, ok := api.ulb.lbs[lbId]
+	if !ok {
+		return nil, newFakeAPIError(4103, "ulb %s is not found", lbId)
+	}
+	return lb, nil
+}
+
+func (api *fakeUCloudAPI) getVServer(lbId, vserverId string) (*ulb.ULBVServerSet, error) {
+	lb, err := api.getULB(lbId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range lb.VServerSet {
+		if lb.VServerSet[i].VServerId == vserverId {
+			return &lb.VServerSet[i], nil
+		}
+	}
+	return nil, newFakeAPIError(4103, "vserver %s is not found", vserverId)
+}
+
+func (api *fakeUCloudAPI) createULB(q fakeQuery) (interface{}, error) {
+	subnetId := q.strOr("SubnetId", fakeAPIDefaultSubnetId)
+	subnet, ok := api.vpc.subnets[subnetId]
+	if !ok {
+		return nil, newFakeAPIError(58005, "subnet %s is not found", subnetId)
+	}
+
+	lb := &ulb.ULBSet{
+		ULBId:      api.newId("ulb"),
+		Name:       q.strOr("ULBName", "ULB"),
+		Tag:        q.strOr("Tag", defaultTag),
+		Remark:     q.str("Remark"),
+		ULBType:    "OuterMode",
+		VPCId:      subnet.VPCId,
+		SubnetId:   subnet.SubnetId,
+		CreateTime: api.now(),
+		ExpireTime: api.now() + 30*24*3600,
+	}
+	lb.ULBName = lb.Name
+
+	// only the outer mode load balancer is protected by firewall
+	if q.str("InnerMode") == "Yes" {
+		lb.ULBType = "InnerMode"
+		lb.PrivateIP = "10.9.255.254"
+	} else {
+		api.grantDefaultFirewallToResource("ULB", lb.ULBId)
+	}
+
+	api.ulb.lbs[lb.ULBId] = lb
+	return &ulb.CreateULBResponse{ULBId: lb.ULBId}, nil
+}
+
+func (api *fakeUCloudAPI) describeULB(q fakeQuery) (interface{}, error) {
+	lbId := q.str("ULBId")
+	if lbId != "" {
+		if _, err := api.getULB(lbId); err != nil {
+			return nil, err
+		}
+	}
+
+	lbs := []ulb.ULBSet{}
+	for _, lb := range api.ulb.lbs {
+		if lbId != "" && lb.ULBId != lbId {
+			continue
+		}
+
+		if v := q.str("VPCId"); v != "" && lb.VPCId != v {
+			continue
+		}
+
+		if v := q.str("SubnetId"); v != "" && lb.SubnetId != v {
+			continue
+		}
+
+		// the public ip of lb is the eip bound to it
+		item := *lb
+		item.IPSet = []ulb.ULBIPSet{}
+		for _, eip := range api.unet.eips {
+			if eip.Resource.ResourceId != lb.ULBId {
+				continue
+			}
+
+			for _, addr := range eip.EIPAddr {
+				item.IPSet = append(item.IPSet, ulb.ULBIPSet{
+					OperatorName: addr.OperatorName,
+					EIP:          addr.IP,
+					EIPId:        eip.EIPId,
+				})
+			}
+		}
+		lbs = append(lbs, item)
+	}
+
+	start, end := q.page(len(lbs))
+	return &ulb.DescribeULBResponse{
+		TotalCount: len(lbs),
+		DataSet:    lbs[start:end],
+	}, nil
+}
+
+func (api *fakeUCloudAPI) updateULBAttribute(q fakeQuery) (interface{}, error) {
+	lb, err := api.getULB(q.str("ULBId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if v := q.str("Name"); v != "" {
+		lb.Name = v
+		lb.ULBName = v
+	}
+
+	if v := q.str("Tag"); v != "" {
+		lb.Tag = v
+	}
+
+	if _, ok := q.Values["Remark"]; ok {
+		lb.Remark = q.str("Remark")
+	}
+
+	return &ulb.UpdateULBAttributeResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) deleteULB(q fakeQuery) (interface{}, error) {
+	lb, err := api.getULB(q.str("ULBId"))
+	if err != nil {
+		return nil, err
+	}
+
+	api.unbindEIPByResource(lb.ULBId)
+	api.revokeFirewallByResource(lb.ULBId)
+
+	delete(api.ulb.lbs, lb.ULBId)
+	return &ulb.DeleteULBResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) createVServer(q fakeQuery) (interface{}, error) {
+	lb, err := api.getULB(q.str("ULBId"))
+	if err != nil {
+		return nil, err
+	}
+
+	vserver := ulb.ULBVServerSet{
+		VServerId:       api.newId("vserver"),
+		VServerName:     q.strOr("VServerName", "VServer"),
+		ListenType:      q.strOr("ListenType", "RequestProxy"),
+		Protocol:        q.strOr("Protocol", "HTTP"),
+		FrontendPort:    q.intOr("FrontendPort", 80),
+		Method:          q.strOr("Method", "Roundrobin"),
+		PersistenceType: q.strOr("PersistenceType", "None"),
+		PersistenceInfo: q.str("PersistenceInfo"),
+		ClientTimeout:   q.intOr("ClientTimeout", 60),
+		MonitorType:     q.strOr("MonitorType", "Port"),
+		Domain:          q.str("Domain"),
+		Path:            q.str("Path"),
+		BackendSet:      []ulb.ULBBackendSet{},
+		PolicySet:       []ulb.ULBPolicySet{},
+	}
+
+	for _, item := range lb.VServerSet {
+		if item.FrontendPort == vserver.FrontendPort {
+			return nil, newFakeAPIError(4031, "frontend port %d of ulb %s is already used", vserver.FrontendPort, lb.ULBId)
+		}
+	}
+
+	lb.VServerSet = append(lb.VServerSet, vserver)
+	return &ulb.CreateVServerResponse{VServerId: vserver.VServerId}, nil
+}
+
+func (api *fakeUCloudAPI) describeVServer(q fakeQuery) (interface{}, error) {
+	lb, err := api.getULB(q.str("ULBId"))
+	if err != nil {
+		return nil, err
+	}
+
+	vserverId := q.str("VServerId")
+	if vserverId != "" {
+		if _, err := api.getVServer(lb.ULBId, vserverId); err != nil {
+			return nil, err
+		}
+	}
+
+	vservers := []ulb.ULBVServerSet{}
+	for _, vserver := range lb.VServerSet {
+		if vserverId != "" && vserver.VServerId != vserverId {
+			continue
+		}
+		vservers = append(vservers, vserver)
+	}
+
+	start, end := q.page(len(vservers))
+	return &ulb.DescribeVServerResponse{
+		TotalCount: len(vservers),
+		DataSet:    vservers[start:end],
+	}, nil
+}
+
+func (api *fakeUCloudAPI) updateVServerAttribute(q fakeQuery) (interface{}, error) {
+	vserver, err := api.getVServer(q.str("ULBId"), q.str("VServerId"))
+	if err != nil {
+		return nil, err
+	}
+
+	vserver.VServerName = q.strOr("VServerName", vserver.VServerName)
+	vserver.Method = q.strOr("Method", vserver.Method)
+	vserver.PersistenceType = q.strOr("PersistenceType", vserver.PersistenceType)
+	vserver.PersistenceInfo = q.strOr("PersistenceInfo", vserver.PersistenceInfo)
+	vserver.ClientTimeout = q.intOr("ClientTimeout", vserver.ClientTimeout)
+	vserver.MonitorType = q.strOr("MonitorType", vserver.MonitorType)
+	vserver.Domain = q.strOr("Domain", vserver.Domain)
+	vserver.Path = q.strOr("Path", vserver.Path)
+
+	return &ulb.UpdateVServerAttributeResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) deleteVServer(q fakeQuery) (interface{}, error) {
+	lb, err := api.getULB(q.str("ULBId"))
+	if err != nil {
+		return nil, err
+	}
+
+	vservers := []ulb.ULBVServerSet{}
+	found := false
+	for _, vserver := range lb.VServerSet {
+		if vserver.VServerId == q.str("VServerId") {
+			found = true
+			continue
+		}
+		vservers = append(vservers, vserver)
+	}
+
+	if !found {
+		return nil, newFakeAPIError(4103, "vserver %s is not found", q.str("VServerId"))
+	}
+
+	lb.VServerSet = vservers
+	return &ulb.DeleteVServerResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) allocateBackend(q fakeQuery) (interface{}, error) {
+	vserver, err := api.getVServer(q.str("ULBId"), q.str("VServerId"))
+	if err != nil {
+		return nil, err
+	}
+
+	backend := ulb.ULBBackendSet{
+		BackendId:    api.newId("backend"),
+		ResourceType: q.str("ResourceType"),
+		ResourceId:   q.str("ResourceId"),
+		Port:         q.intOr("Port", 80),
+		Enabled:      q.intOr("Enabled", 1),
+		Status:       0,
+	}
+
+	if backend.ResourceType == "UHost" {
+		instance, err := api.getUHostInstance(backend.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+
+		backend.ResourceName = instance.Name
+		for _, item := range instance.IPSet {
+			if item.Type == "Private" {
+				backend.PrivateIP = item.IP
+				backend.SubnetId = item.SubnetId
+			}
+		}
+	}
+
+	vserver.BackendSet = append(vserver.BackendSet, backend)
+	return &ulb.AllocateBackendResponse{BackendId: backend.BackendId}, nil
+}
+
+func (api *fakeUCloudAPI) getBackend(lbId, backendId string) (*ulb.ULBVServerSet, *ulb.ULBBackendSet, error) {
+	lb, err := api.getULB(lbId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range lb.VServerSet {
+		vserver := &lb.VServerSet[i]
+		for j := range vserver.BackendSet {
+			if vserver.BackendSet[j].BackendId == backendId {
+				return vserver, &vserver.BackendSet[j], nil
+			}
+		}
+	}
+	return nil, nil, newFakeAPIError(4103, "backend %s is not found", backendId)
+}
+
+func (api *fakeUCloudAPI) updateBackendAttribute(q fakeQuery) (interface{}, error) {
+	_, backend, err := api.getBackend(q.str("ULBId"), q.str("BackendId"))
+	if err != nil {
+		return nil, err
+	}
+
+	backend.Port = q.intOr("Port", backend.Port)
+	backend.Enabled = q.intOr("Enabled", backend.Enabled)
+	return &ulb.UpdateBackendAttributeResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) releaseBackend(q fakeQuery) (interface{}, error) {
+	vserver, _, err := api.getBackend(q.str("ULBId"), q.str("BackendId"))
+	if err != nil {
+		return nil, err
+	}
+
+	removeFakeBackend(vserver, q.str("BackendId"))
+	return &ulb.ReleaseBackendResponse{}, nil
+}
+
+// releaseBackendByResource will release the backends of the resource when the resource is deleted
+func (api *fakeUCloudAPI) releaseBackendByResource(resourceId string) {
+	for _, lb := range api.ulb.lbs {
+		for i := range lb.VServerSet {
+			vserver := &lb.VServerSet[i]
+			for _, backend := range vserver.BackendSet {
+				if backend.ResourceId == resourceId {
+					removeFakeBackend(vserver, backend.BackendId)
+				}
+			}
+		}
+	}
+}
+
+func removeFakeBackend(vserver *ulb.ULBVServerSet, backendId string) {
+	backends := []ulb.ULBBackendSet{}
+	for _, backend := range vserver.BackendSet {
+		if backend.BackendId != backendId {
+			backends = append(backends, backend)
+		}
+	}
+	vserver.BackendSet = backends
+
+	for i := range vserver.PolicySet {
+		policy := &vserver.PolicySet[i]
+		policyBackends := []ulb.PolicyBackendSet{}
+		for _, backend := range policy.BackendSet {
+			if backend.BackendId != backendId {
+				policyBackends = append(policyBackends, backend)
+			}
+		}
+		policy.BackendSet = policyBackends
+	}
+}
+
+// buildFakePolicyBackends will returns the backends of policy by the BackendId list parameter
+func buildFakePolicyBackends(vserver *ulb.ULBVServerSet, backendIds []string) ([]ulb.PolicyBackendSet, error) {
+	backends := []ulb.PolicyBackendSet{}
+	for _, backendId := range backendIds {
+		found := false
+		for _, backend := range vserver.BackendSet {
+			if backend.BackendId == backendId {
+				backends = append(backends, ulb.PolicyBackendSet{
+					BackendId:    backend.BackendId,
+					ObjectId:     backend.ResourceId,
+					Port:         backend.Port,
+					PrivateIP:    backend.PrivateIP,
+					ResourceName: backend.ResourceName,
+				})
+				found = true
+			}
+		}
+
+		if !found {
+			return nil, newFakeAPIError(4103, "backend %s is not found", backendId)
+		}
+	}
+	return backends, nil
+}
+
+func (api *fakeUCloudAPI) createPolicy(q fakeQuery) (interface{}, error) {
+	vserver, err := api.getVServer(q.str("ULBId"), q.str("VServerId"))
+	if err != nil {
+		return nil, err
+	}
+
+	backends, err := buildFakePolicyBackends(vserver, q.list("BackendId"))
+	if err != nil {
+		return nil, err
+	}
+
+	policy := ulb.ULBPolicySet{
+		PolicyId:   api.newId("policy"),
+		PolicyType: "Custom",
+		Type:       q.strOr("Type", "Domain"),
+		Match:      q.str("Match"),
+		VServerId:  vserver.VServerId,
+		TotalCount: len(backends),
+		BackendSet: backends,
+	}
+
+	vserver.PolicySet = append(vserver.PolicySet, policy)
+	return &ulb.CreatePolicyResponse{PolicyId: policy.PolicyId}, nil
+}
+
+func (api *fakeUCloudAPI) updatePolicy(q fakeQuery) (interface{}, error) {
+	vserver, err := api.getVServer(q.str("ULBId"), q.str("VServerId"))
+	if err != nil {
+		return nil, err
+	}
+
+	backends, err := buildFakePolicyBackends(vserver, q.list("BackendId"))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range vserver.PolicySet {
+		policy := &vserver.PolicySet[i]
+		if policy.PolicyId == q.str("PolicyId") {
+			policy.Match = q.str("Match")
+			policy.Type = q.strOr("Type", policy.Type)
+			policy.BackendSet = backends
+			policy.TotalCount = len(backends)
+			return &ulb.UpdatePolicyResponse{PolicyId: policy.PolicyId}, nil
+		}
+	}
+
+	return nil, newFakeAPIError(4103, "policy %s is not found", q.str("PolicyId"))
+}
+
+func (api *fakeUCloudAPI) deletePolicy(q fakeQuery) (interface{}, error) {
+	policyId := q.str("PolicyId")
+	for _, lb := range api.ulb.lbs {
+		for i := range lb.VServerSet {
+			vserver := &lb.VServerSet[i]
+			policies := []ulb.ULBPolicySet{}
+			for _, policy := range vserver.PolicySet {
+				if policy.PolicyId != policyId {
+					policies = append(policies, policy)
+				}
+			}
+
+			if len(policies) != len(vserver.PolicySet) {
+				vserver.PolicySet = policies
+				return &ulb.DeletePolicyResponse{}, nil
+			}
+		}
+	}
+
+	return nil, newFakeAPIError(4103, "policy %s is not found", policyId)
+}
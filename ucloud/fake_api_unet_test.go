@@ -0,0 +1,798 @@
+package ucloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
+)
+
+// fakeUNetState is the in-memory state of unet product
+type fakeUNetState struct {
+	eips              map[string]*unet.UnetEIPSet
+	shareBandwidths   map[string]*unet.UnetShareBandwidthSet
+	bandwidthPackages map[string]*unet.UnetBandwidthPackageSet
+	vips              map[string]*unet.VIPDetailSet
+	firewalls         map[string]*unet.FirewallDataSet
+
+	// firewallResources is the resources granted to firewall, keyed by resource id
+	firewallResources map[string]*fakeFirewallResource
+	ipSeq             int
+
+	// defaultFWId is the default firewall granted to the resources created without firewall
+	defaultFWId string
+}
+
+// fakeFirewallResource is the resource which is granted to firewall
+type fakeFirewallResource struct {
+	fwId     string
+	resource unet.ResourceSet
+}
+
+func (api *fakeUCloudAPI) registerUNet() {
+	api.unet = &fakeUNetState{
+		eips:              map[string]*unet.UnetEIPSet{},
+		shareBandwidths:   map[string]*unet.UnetShareBandwidthSet{},
+		bandwidthPackages: map[string]*unet.UnetBandwidthPackageSet{},
+		vips:              map[string]*unet.VIPDetailSet{},
+		firewalls:         map[string]*unet.FirewallDataSet{},
+		firewallResources: map[string]*fakeFirewallResource{},
+	}
+	api.createDefaultFirewalls()
+
+	api.register("AllocateEIP", api.allocateEIP)
+	api.register("DescribeEIP", api.describeEIP)
+	api.register("UpdateEIPAttribute", api.updateEIPAttribute)
+	api.register("ModifyEIPBandwidth", api.modifyEIPBandwidth)
+	api.register("SetEIPPayMode", api.setEIPPayMode)
+	api.register("ReleaseEIP", api.releaseEIP)
+	api.register("BindEIP", api.bindEIP)
+	api.register("UnBindEIP", api.unBindEIP)
+	api.register("GetEIPPrice", api.getEIPPrice)
+
+	api.register("AllocateShareBandwidth", api.allocateShareBandwidth)
+	api.register("DescribeShareBandwidth", api.describeShareBandwidth)
+	api.register("ResizeShareBandwidth", api.resizeShareBandwidth)
+	api.register("ReleaseShareBandwidth", api.releaseShareBandwidth)
+	api.register("AssociateEIPWithShareBandwidth", api.associateEIPWithShareBandwidth)
+	api.register("DisassociateEIPWithShareBandwidth", api.disassociateEIPWithShareBandwidth)
+
+	api.register("CreateBandwidthPackage", api.createBandwidthPackage)
+	api.register("DescribeBandwidthPackage", api.describeBandwidthPackage)
+	api.register("DeleteBandwidthPackage", api.deleteBandwidthPackage)
+
+	api.register("AllocateVIP", api.allocateVIP)
+	api.register("DescribeVIP", api.describeVIP)
+	api.register("ReleaseVIP", api.releaseVIP)
+
+	api.register("CreateFirewall", api.createFirewall)
+	api.register("DescribeFirewall", api.describeFirewall)
+	api.register("UpdateFirewall", api.updateFirewall)
+	api.register("UpdateFirewallAttribute", api.updateFirewallAttribute)
+	api.register("DeleteFirewall", api.deleteFirewall)
+	api.register("GrantFirewall", api.grantFirewall)
+	api.register("DescribeFirewallResource", api.describeFirewallResource)
+}
+
+func (api *fakeUCloudAPI) getEIP(eipId string) (*unet.UnetEIPSet, error) {
+	eip, ok := api.unet.eips[eipId]
+	if !ok {
+		return nil, newFakeAPIError(8039, "eip %s is not found", eipId)
+	}
+	return eip, nil
+}
+
+func (api *fakeUCloudAPI) allocateEIP(q fakeQuery) (interface{}, error) {
+	api.unet.ipSeq++
+
+	eip := &unet.UnetEIPSet{
+		EIPId:      api.newId("eip"),
+		Bandwidth:  q.intOr("Bandwidth", 1),
+		Status:     "free",
+		ChargeType: q.strOr("ChargeType", "Month"),
+		PayMode:    q.strOr("PayMode", "Bandwidth"),
+		Name:       q.strOr("Name", "EIP"),
+		Tag:        q.strOr("Tag", defaultTag),
+		Remark:     q.str("Remark"),
+		CreateTime: api.now(),
+		ExpireTime: api.now() + 30*24*3600,
+		EIPAddr: []unet.UnetEIPAddrSet{
+			{
+				OperatorName: q.strOr("OperatorName", "Bgp"),
+				IP:           fmt.Sprintf("106.75.%d.%d", api.unet.ipSeq/250, api.unet.ipSeq%250+1),
+			},
+		},
+	}
+
+	if v := q.str("ShareBandwidthId"); v != "" {
+		shareBandwidth, err := api.getShareBandwidth(v)
+		if err != nil {
+			return nil, err
+		}
+		api.joinShareBandwidth(eip, shareBandwidth)
+	}
+
+	api.unet.eips[eip.EIPId] = eip
+	return &unet.AllocateEIPResponse{
+		EIPSet: []unet.UnetAllocateEIPSet{
+			{EIPId: eip.EIPId, EIPAddr: eip.EIPAddr},
+		},
+	}, nil
+}
+
+func (api *fakeUCloudAPI) describeEIP(q fakeQuery) (interface{}, error) {
+	ids := q.list("EIPIds")
+
+	eips := []unet.UnetEIPSet{}
+	for _, eip := range api.unet.eips {
+		if len(ids) > 0 && !isStringIn(eip.EIPId, ids) {
+			continue
+		}
+		eips = append(eips, *eip)
+	}
+
+	start, end := q.page(len(eips))
+	return &unet.DescribeEIPResponse{
+		TotalCount: len(eips),
+		EIPSet:     eips[start:end],
+	}, nil
+}
+
+func (api *fakeUCloudAPI) updateEIPAttribute(q fakeQuery) (interface{}, error) {
+	eip, err := api.getEIP(q.str("EIPId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if v := q.str("Name"); v != "" {
+		eip.Name = v
+	}
+
+	if v := q.str("Tag"); v != "" {
+		eip.Tag = v
+	}
+
+	if _, ok := q.Values["Remark"]; ok {
+		eip.Remark = q.str("Remark")
+	}
+
+	return &unet.UpdateEIPAttributeResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) modifyEIPBandwidth(q fakeQuery) (interface{}, error) {
+	eip, err := api.getEIP(q.str("EIPId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if eip.PayMode == "ShareBandwidth" {
+		return nil, newFakeAPIError(8124, "eip %s is a member of share bandwidth", eip.EIPId)
+	}
+
+	eip.Bandwidth = q.int("Bandwidth")
+	return &unet.ModifyEIPBandwidthResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) setEIPPayMode(q fakeQuery) (interface{}, error) {
+	eip, err := api.getEIP(q.str("EIPId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if eip.PayMode == "ShareBandwidth" {
+		return nil, newFakeAPIError(8124, "eip %s is a member of share bandwidth", eip.EIPId)
+	}
+
+	eip.PayMode = q.str("PayMode")
+	eip.Bandwidth = q.intOr("Bandwidth", eip.Bandwidth)
+	return &unet.SetEIPPayModeResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) releaseEIP(q fakeQuery) (interface{}, error) {
+	eip, err := api.getEIP(q.str("EIPId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if eip.Status == "used" {
+		return nil, newFakeAPIError(8041, "eip %s is bound to resource %s", eip.EIPId, eip.Resource.ResourceId)
+	}
+
+	for id, item := range api.unet.bandwidthPackages {
+		if item.EIPId == eip.EIPId {
+			delete(api.unet.bandwidthPackages, id)
+		}
+	}
+
+	delete(api.unet.eips, eip.EIPId)
+	return &unet.ReleaseEIPResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) bindEIP(q fakeQuery) (interface{}, error) {
+	eip, err := api.getEIP(q.str("EIPId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if eip.Status == "used" {
+		return nil, newFakeAPIError(8042, "eip %s is already bound to resource %s", eip.EIPId, eip.Resource.ResourceId)
+	}
+
+	eip.Status = "used"
+	eip.Resource = unet.UnetEIPResourceSet{
+		EIPId:        eip.EIPId,
+		ResourceType: q.str("ResourceType"),
+		ResourceId:   q.str("ResourceId"),
+	}
+	return &unet.BindEIPResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) unBindEIP(q fakeQuery) (interface{}, error) {
+	eip, err := api.getEIP(q.str("EIPId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if eip.Resource.ResourceId != q.str("ResourceId") {
+		return nil, newFakeAPIError(8043, "eip %s is not bound to resource %s", eip.EIPId, q.str("ResourceId"))
+	}
+
+	eip.Status = "free"
+	eip.Resource = unet.UnetEIPResourceSet{}
+	return &unet.UnBindEIPResponse{}, nil
+}
+
+// unbindEIPByResource will unbind the eip from the resource when the resource is deleted
+func (api *fakeUCloudAPI) unbindEIPByResource(resourceId string) {
+	for _, eip := range api.unet.eips {
+		if eip.Resource.ResourceId == resourceId {
+			eip.Status = "free"
+			eip.Resource = unet.UnetEIPResourceSet{}
+		}
+	}
+}
+
+func (api *fakeUCloudAPI) getShareBandwidth(shareBandwidthId string) (*unet.UnetShareBandwidthSet, error) {
+	shareBandwidth, ok := api.unet.shareBandwidths[shareBandwidthId]
+	if !ok {
+		return nil, newFakeAPIError(8120, "share bandwidth %s is not found", shareBandwidthId)
+	}
+	return shareBandwidth, nil
+}
+
+// joinShareBandwidth will move the eip into the share bandwidth
+func (api *fakeUCloudAPI) joinShareBandwidth(eip *unet.UnetEIPSet, shareBandwidth *unet.UnetShareBandwidthSet) {
+	eip.PayMode = "ShareBandwidth"
+	eip.ShareBandwidthSet = unet.ShareBandwidthSet{
+		ShareBandwidthId:   shareBandwidth.ShareBandwidthId,
+		ShareBandwidthName: shareBandwidth.Name,
+		ShareBandwidth:     shareBandwidth.ShareBandwidth,
+	}
+}
+
+// leaveShareBandwidth will move the eip out of the share bandwidth with its own bandwidth and pay mode
+func (api *fakeUCloudAPI) leaveShareBandwidth(eip *unet.UnetEIPSet, bandwidth int, payMode string) {
+	eip.PayMode = payMode
+	eip.Bandwidth = bandwidth
+	eip.ShareBandwidthSet = unet.ShareBandwidthSet{}
+}
+
+func (api *fakeUCloudAPI) allocateShareBandwidth(q fakeQuery) (interface{}, error) {
+	if q.int("ShareBandwidth") < 20 {
+		return nil, newFakeAPIError(8121, "share bandwidth should be at least 20, got %d", q.int("ShareBandwidth"))
+	}
+
+	shareBandwidth := &unet.UnetShareBandwidthSet{
+		ShareBandwidthId: api.newId("bwshare"),
+		Name:             q.str("Name"),
+		ShareBandwidth:   q.int("ShareBandwidth"),
+		ChargeType:       q.str("ChargeType"),
+		CreateTime:       api.now(),
+		ExpireTime:       api.now() + 30*24*3600,
+	}
+
+	api.unet.shareBandwidths[shareBandwidth.ShareBandwidthId] = shareBandwidth
+	return &unet.AllocateShareBandwidthResponse{ShareBandwidthId: shareBandwidth.ShareBandwidthId}, nil
+}
+
+// describeShareBandwidth will returns the share bandwidths with the eips in them
+func (api *fakeUCloudAPI) describeShareBandwidth(q fakeQuery) (interface{}, error) {
+	ids := q.list("ShareBandwidthIds")
+
+	shareBandwidths := []unet.UnetShareBandwidthSet{}
+	for _, shareBandwidth := range api.unet.shareBandwidths {
+		if len(ids) > 0 && !isStringIn(shareBandwidth.ShareBandwidthId, ids) {
+			continue
+		}
+
+		item := *shareBandwidth
+		item.EIPSet = []unet.EIPSetData{}
+		for _, eip := range api.unet.eips {
+			if eip.ShareBandwidthSet.ShareBandwidthId != item.ShareBandwidthId {
+				continue
+			}
+
+			data := unet.EIPSetData{EIPId: eip.EIPId, Badnwidth: eip.Bandwidth}
+			for _, addr := range eip.EIPAddr {
+				data.EIPAddr = append(data.EIPAddr, unet.EIPAddrSet{OperatorName: addr.OperatorName, IP: addr.IP})
+			}
+			item.EIPSet = append(item.EIPSet, data)
+		}
+		shareBandwidths = append(shareBandwidths, item)
+	}
+
+	return &unet.DescribeShareBandwidthResponse{
+		TotalCount: len(shareBandwidths),
+		DataSet:    shareBandwidths,
+	}, nil
+}
+
+func (api *fakeUCloudAPI) resizeShareBandwidth(q fakeQuery) (interface{}, error) {
+	shareBandwidth, err := api.getShareBandwidth(q.str("ShareBandwidthId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if q.int("ShareBandwidth") < 20 {
+		return nil, newFakeAPIError(8121, "share bandwidth should be at least 20, got %d", q.int("ShareBandwidth"))
+	}
+
+	shareBandwidth.ShareBandwidth = q.int("ShareBandwidth")
+	for _, eip := range api.unet.eips {
+		if eip.ShareBandwidthSet.ShareBandwidthId == shareBandwidth.ShareBandwidthId {
+			api.joinShareBandwidth(eip, shareBandwidth)
+		}
+	}
+
+	return &unet.ResizeShareBandwidthResponse{}, nil
+}
+
+// releaseShareBandwidth will release the share bandwidth, the eips in it are moved out with EIPBandwidth
+func (api *fakeUCloudAPI) releaseShareBandwidth(q fakeQuery) (interface{}, error) {
+	shareBandwidth, err := api.getShareBandwidth(q.str("ShareBandwidthId"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, eip := range api.unet.eips {
+		if eip.ShareBandwidthSet.ShareBandwidthId == shareBandwidth.ShareBandwidthId {
+			api.leaveShareBandwidth(eip, q.int("EIPBandwidth"), q.strOr("PayMode", "Bandwidth"))
+		}
+	}
+
+	delete(api.unet.shareBandwidths, shareBandwidth.ShareBandwidthId)
+	return &unet.ReleaseShareBandwidthResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) associateEIPWithShareBandwidth(q fakeQuery) (interface{}, error) {
+	shareBandwidth, err := api.getShareBandwidth(q.str("ShareBandwidthId"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, eipId := range q.list("EIPIds") {
+		eip, err := api.getEIP(eipId)
+		if err != nil {
+			return nil, err
+		}
+
+		if v := eip.ShareBandwidthSet.ShareBandwidthId; v != "" {
+			return nil, newFakeAPIError(8122, "eip %s is already in share bandwidth %s", eip.EIPId, v)
+		}
+		api.joinShareBandwidth(eip, shareBandwidth)
+	}
+
+	return &unet.AssociateEIPWithShareBandwidthResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) disassociateEIPWithShareBandwidth(q fakeQuery) (interface{}, error) {
+	shareBandwidth, err := api.getShareBandwidth(q.str("ShareBandwidthId"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, eipId := range q.list("EIPIds") {
+		eip, err := api.getEIP(eipId)
+		if err != nil {
+			return nil, err
+		}
+
+		if eip.ShareBandwidthSet.ShareBandwidthId != shareBandwidth.ShareBandwidthId {
+			return nil, newFakeAPIError(8123, "eip %s is not in share bandwidth %s", eip.EIPId, shareBandwidth.ShareBandwidthId)
+		}
+		api.leaveShareBandwidth(eip, q.int("Bandwidth"), q.strOr("PayMode", "Bandwidth"))
+	}
+
+	return &unet.DisassociateEIPWithShareBandwidthResponse{}, nil
+}
+
+// createBandwidthPackage will create the bandwidth package of eip, it is enabled immediately if EnableTime is not specified,
+// and it will be expired after TimeRange hours.
+func (api *fakeUCloudAPI) createBandwidthPackage(q fakeQuery) (interface{}, error) {
+	eip, err := api.getEIP(q.str("EIPId"))
+	if err != nil {
+		return nil, err
+	}
+
+	enableTime := q.intOr("EnableTime", api.now())
+	if enableTime < api.now() {
+		return nil, newFakeAPIError(8130, "enable time %d should not be earlier than now", enableTime)
+	}
+
+	packageSet := &unet.UnetBandwidthPackageSet{
+		BandwidthPackageId: api.newId("bwpack"),
+		EIPId:              eip.EIPId,
+		Bandwidth:          q.int("Bandwidth"),
+		EnableTime:         enableTime,
+		DisableTime:        enableTime + q.int("TimeRange")*3600,
+		CreateTime:         api.now(),
+	}
+
+	for _, addr := range eip.EIPAddr {
+		packageSet.EIPAddr = append(packageSet.EIPAddr, unet.EIPAddrSet{OperatorName: addr.OperatorName, IP: addr.IP})
+	}
+
+	api.unet.bandwidthPackages[packageSet.BandwidthPackageId] = packageSet
+	return &unet.CreateBandwidthPackageResponse{BandwidthPackageId: packageSet.BandwidthPackageId}, nil
+}
+
+func (api *fakeUCloudAPI) describeBandwidthPackage(q fakeQuery) (interface{}, error) {
+	packages := []unet.UnetBandwidthPackageSet{}
+	for _, packageSet := range api.unet.bandwidthPackages {
+		packages = append(packages, *packageSet)
+	}
+
+	start, end := q.page(len(packages))
+	return &unet.DescribeBandwidthPackageResponse{
+		TotalCount: len(packages),
+		DataSets:   packages[start:end],
+	}, nil
+}
+
+func (api *fakeUCloudAPI) deleteBandwidthPackage(q fakeQuery) (interface{}, error) {
+	packageId := q.str("BandwidthPackageId")
+	if _, ok := api.unet.bandwidthPackages[packageId]; !ok {
+		return nil, newFakeAPIError(8131, "bandwidth package %s is not found", packageId)
+	}
+
+	delete(api.unet.bandwidthPackages, packageId)
+	return &unet.DeleteBandwidthPackageResponse{}, nil
+}
+
+// allocateVIP will allocate the vips from the subnet in vpc
+func (api *fakeUCloudAPI) allocateVIP(q fakeQuery) (interface{}, error) {
+	subnet, ok := api.vpc.subnets[q.str("SubnetId")]
+	if !ok {
+		return nil, newFakeAPIError(58005, "subnet %s is not found", q.str("SubnetId"))
+	}
+
+	if subnet.VPCId != q.str("VPCId") {
+		return nil, newFakeAPIError(58006, "subnet %s is not in vpc %s", subnet.SubnetId, q.str("VPCId"))
+	}
+
+	resp := &unet.AllocateVIPResponse{}
+	for i := 0; i < q.intOr("Count", 1); i++ {
+		ip, err := api.allocateSubnetIP(subnet)
+		if err != nil {
+			return nil, err
+		}
+
+		vip := &unet.VIPDetailSet{
+			VIPId:      api.newId("vip"),
+			Zone:       q.strOr("Zone", fakeAPIZones[0]),
+			VIP:        ip,
+			VPCId:      subnet.VPCId,
+			SubnetId:   subnet.SubnetId,
+			Name:       q.strOr("Name", "VIP"),
+			CreateTime: api.now(),
+		}
+
+		api.unet.vips[vip.VIPId] = vip
+		resp.VIPSet = append(resp.VIPSet, unet.VIPSet{VIP: vip.VIP, VIPId: vip.VIPId, VPCId: vip.VPCId})
+		resp.DataSet = append(resp.DataSet, vip.VIP)
+	}
+
+	return resp, nil
+}
+
+func (api *fakeUCloudAPI) describeVIP(q fakeQuery) (interface{}, error) {
+	resp := &unet.DescribeVIPResponse{}
+	for _, vip := range api.unet.vips {
+		if v := q.str("VPCId"); v != "" && vip.VPCId != v {
+			continue
+		}
+
+		if v := q.str("SubnetId"); v != "" && vip.SubnetId != v {
+			continue
+		}
+
+		resp.VIPSet = append(resp.VIPSet, *vip)
+		resp.DataSet = append(resp.DataSet, vip.VIP)
+	}
+
+	resp.TotalCount = len(resp.VIPSet)
+	return resp, nil
+}
+
+func (api *fakeUCloudAPI) releaseVIP(q fakeQuery) (interface{}, error) {
+	vipId := q.str("VIPId")
+	if _, ok := api.unet.vips[vipId]; !ok {
+		return nil, newFakeAPIError(8140, "vip %s is not found", vipId)
+	}
+
+	delete(api.unet.vips, vipId)
+	return &unet.ReleaseVIPResponse{}, nil
+}
+
+// parseFakeFirewallRules will parse the rules of firewall, such as "TCP|22|0.0.0.0/0|ACCEPT|HIGH"
+func parseFakeFirewallRules(values []string) ([]unet.FirewallRuleSet, error) {
+	rules := []unet.FirewallRuleSet{}
+	for _, v := range values {
+		parts := strings.Split(v, "|")
+		if len(parts) != 5 {
+			return nil, newFakeAPIError(230, "firewall rule %q is invalid", v)
+		}
+
+		rules = append(rules, unet.FirewallRuleSet{
+			ProtocolType: parts[0],
+			DstPort:      parts[1],
+			SrcIP:        parts[2],
+			RuleAction:   parts[3],
+			Priority:     parts[4],
+		})
+	}
+	return rules, nil
+}
+
+func (api *fakeUCloudAPI) getFirewall(fwId string) (*unet.FirewallDataSet, error) {
+	firewall, ok := api.unet.firewalls[fwId]
+	if !ok {
+		return nil, newFakeAPIError(54002, "firewall %s is not found", fwId)
+	}
+	return firewall, nil
+}
+
+func (api *fakeUCloudAPI) createFirewall(q fakeQuery) (interface{}, error) {
+	rules, err := parseFakeFirewallRules(q.list("Rule"))
+	if err != nil {
+		return nil, err
+	}
+
+	// at least one rule is required to create firewall, but all of the rules can be removed by UpdateFirewall
+	if len(rules) == 0 {
+		return nil, newFakeAPIError(230, "firewall rule is required")
+	}
+
+	firewall := &unet.FirewallDataSet{
+		FWId:       api.newId("firewall"),
+		Name:       q.str("Name"),
+		Tag:        q.strOr("Tag", defaultTag),
+		Remark:     q.str("Remark"),
+		Type:       "user defined",
+		Rule:       rules,
+		CreateTime: api.now(),
+	}
+	firewall.GroupId = strings.TrimPrefix(firewall.FWId, "firewall-")
+
+	api.unet.firewalls[firewall.FWId] = firewall
+	return &unet.CreateFirewallResponse{FWId: firewall.FWId}, nil
+}
+
+func (api *fakeUCloudAPI) describeFirewall(q fakeQuery) (interface{}, error) {
+	fwId := q.str("FWId")
+	if fwId != "" {
+		if _, err := api.getFirewall(fwId); err != nil {
+			return nil, err
+		}
+	}
+
+	// the firewall of resource is filtered by ResourceType and ResourceId
+	resourceFWId := ""
+	if v := q.str("ResourceId"); v != "" {
+		granted, ok := api.unet.firewallResources[v]
+		if !ok {
+			return &unet.DescribeFirewallResponse{}, nil
+		}
+		resourceFWId = granted.fwId
+	}
+
+	firewalls := []unet.FirewallDataSet{}
+	for _, firewall := range api.unet.firewalls {
+		if fwId != "" && firewall.FWId != fwId {
+			continue
+		}
+
+		if resourceFWId != "" && firewall.FWId != resourceFWId {
+			continue
+		}
+
+		item := *firewall
+		item.ResourceCount = 0
+		for _, granted := range api.unet.firewallResources {
+			if granted.fwId == item.FWId {
+				item.ResourceCount++
+			}
+		}
+		firewalls = append(firewalls, item)
+	}
+
+	start, end := q.page(len(firewalls))
+	return &unet.DescribeFirewallResponse{DataSet: firewalls[start:end]}, nil
+}
+
+func (api *fakeUCloudAPI) updateFirewall(q fakeQuery) (interface{}, error) {
+	firewall, err := api.getFirewall(q.str("FWId"))
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := parseFakeFirewallRules(q.list("Rule"))
+	if err != nil {
+		return nil, err
+	}
+
+	firewall.Rule = rules
+	return &unet.UpdateFirewallResponse{FWId: firewall.FWId}, nil
+}
+
+func (api *fakeUCloudAPI) updateFirewallAttribute(q fakeQuery) (interface{}, error) {
+	firewall, err := api.getFirewall(q.str("FWId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if v := q.str("Name"); v != "" {
+		firewall.Name = v
+	}
+
+	if v := q.str("Tag"); v != "" {
+		firewall.Tag = v
+	}
+
+	if _, ok := q.Values["Remark"]; ok {
+		firewall.Remark = q.str("Remark")
+	}
+
+	return &unet.UpdateFirewallAttributeResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) deleteFirewall(q fakeQuery) (interface{}, error) {
+	firewall, err := api.getFirewall(q.str("FWId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if firewall.Type != "user defined" {
+		return nil, newFakeAPIError(54005, "the default firewall %s can not be deleted", firewall.FWId)
+	}
+
+	for id, granted := range api.unet.firewallResources {
+		if granted.fwId == firewall.FWId {
+			return nil, newFakeAPIError(54004, "firewall %s is used by resource %s", firewall.FWId, id)
+		}
+	}
+
+	delete(api.unet.firewalls, firewall.FWId)
+	return &unet.DeleteFirewallResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) grantFirewall(q fakeQuery) (interface{}, error) {
+	firewall, err := api.getFirewall(q.str("FWId"))
+	if err != nil {
+		return nil, err
+	}
+
+	resourceType, resourceId := q.str("ResourceType"), q.str("ResourceId")
+	switch resourceType {
+	case "UHost":
+		if _, err := api.getUHostInstance(resourceId); err != nil {
+			return nil, err
+		}
+	case "ULB":
+		if _, err := api.getULB(resourceId); err != nil {
+			return nil, err
+		}
+	}
+
+	api.grantFirewallToResource(firewall.FWId, resourceType, resourceId)
+	return &unet.GrantFirewallResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) describeFirewallResource(q fakeQuery) (interface{}, error) {
+	firewall, err := api.getFirewall(q.str("FWId"))
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []unet.ResourceSet{}
+	for _, granted := range api.unet.firewallResources {
+		if granted.fwId != firewall.FWId {
+			continue
+		}
+
+		item := granted.resource
+		if instance, ok := api.uhost.instances[item.ResourceID]; ok {
+			item.Name = instance.Name
+			if len(instance.IPSet) > 0 {
+				item.PrivateIP = instance.IPSet[0].IP
+			}
+		}
+
+		if lb, ok := api.ulb.lbs[item.ResourceID]; ok {
+			item.Name = lb.Name
+			item.PrivateIP = lb.PrivateIP
+		}
+		resources = append(resources, item)
+	}
+
+	start, end := q.page(len(resources))
+	return &unet.DescribeFirewallResourceResponse{ResourceSet: resources[start:end], TotalCount: len(resources)}, nil
+}
+
+// createDefaultFirewalls will create the default firewalls of project, which can not be deleted by user
+func (api *fakeUCloudAPI) createDefaultFirewalls() {
+	defaults := []struct {
+		name     string
+		fwType   string
+		tcpPorts []string
+	}{
+		{"Web服务器推荐", "recommend web", []string{"22", "3389", "80", "443"}},
+		{"非Web服务器推荐", "recommend non web", []string{"22", "3389"}},
+	}
+
+	for _, item := range defaults {
+		firewall := &unet.FirewallDataSet{
+			FWId:       api.newId("firewall"),
+			Name:       item.name,
+			Tag:        defaultTag,
+			Type:       item.fwType,
+			CreateTime: api.now(),
+		}
+		firewall.GroupId = strings.TrimPrefix(firewall.FWId, "firewall-")
+
+		for _, port := range item.tcpPorts {
+			firewall.Rule = append(firewall.Rule, unet.FirewallRuleSet{ProtocolType: "TCP", DstPort: port, SrcIP: "0.0.0.0/0", RuleAction: "ACCEPT", Priority: "HIGH"})
+		}
+		firewall.Rule = append(firewall.Rule, unet.FirewallRuleSet{ProtocolType: "ICMP", SrcIP: "0.0.0.0/0", RuleAction: "ACCEPT", Priority: "HIGH"})
+
+		api.unet.firewalls[firewall.FWId] = firewall
+		if item.fwType == "recommend non web" {
+			api.unet.defaultFWId = firewall.FWId
+		}
+	}
+}
+
+// grantDefaultFirewallToResource will grant the default firewall to the resource created without firewall
+func (api *fakeUCloudAPI) grantDefaultFirewallToResource(resourceType, resourceId string) {
+	api.grantFirewallToResource(api.unet.defaultFWId, resourceType, resourceId)
+}
+
+// grantFirewallToResource will replace the firewall of the resource, each resource has only one firewall
+func (api *fakeUCloudAPI) grantFirewallToResource(fwId, resourceType, resourceId string) {
+	api.unet.firewallResources[resourceId] = &fakeFirewallResource{
+		fwId: fwId,
+		resource: unet.ResourceSet{
+			ResourceID:   resourceId,
+			ResourceType: resourceType,
+		},
+	}
+}
+
+// revokeFirewallByResource will remove the firewall of the resource when the resource is deleted
+func (api *fakeUCloudAPI) revokeFirewallByResource(resourceId string) {
+	delete(api.unet.firewallResources, resourceId)
+}
+
+// getEIPPrice will returns the price by bandwidth, the price is not multiplied by quantity as the same as remote api
+func (api *fakeUCloudAPI) getEIPPrice(q fakeQuery) (interface{}, error) {
+	hourly := 0.02 * float64(q.int("Bandwidth"))
+	if q.str("OperatorName") == "International" {
+		hourly *= 4
+	}
+
+	resp := &unet.GetEIPPriceResponse{}
+	for _, price := range q.prices(hourly) {
+		resp.PriceSet = append(resp.PriceSet, unet.EIPPriceDetailSet{ChargeType: price.ChargeType, Price: price.Price})
+	}
+	return resp, nil
+}
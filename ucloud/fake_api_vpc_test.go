@@ -0,0 +1,374 @@
+package ucloud
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/ucloud/ucloud-sdk-go/services/vpc"
+)
+
+const (
+	// fakeAPIDefaultVPCId is the default vpc of fake api, which is used by instance without vpc specified
+	fakeAPIDefaultVPCId = "uvnet-fakedefault"
+
+	// fakeAPIDefaultSubnetId is the default subnet of fake api, which is in the default vpc
+	fakeAPIDefaultSubnetId = "subnet-fakedefault"
+)
+
+// fakeVPCState is the in-memory state of vpc product
+type fakeVPCState struct {
+	vpcs      map[string]*vpc.VPCInfo
+	subnets   map[string]*vpc.VPCSubnetInfoSet
+	intercoms []fakeVPCIntercom
+}
+
+// fakeVPCIntercom is the peering connection from the vpc to the vpc in destination region and project
+type fakeVPCIntercom struct {
+	vpcId        string
+	dstVPCId     string
+	dstRegion    string
+	dstProjectId string
+}
+
+func (api *fakeUCloudAPI) registerVPC() {
+	api.vpc = &fakeVPCState{
+		vpcs:    map[string]*vpc.VPCInfo{},
+		subnets: map[string]*vpc.VPCSubnetInfoSet{},
+	}
+
+	api.vpc.vpcs[fakeAPIDefaultVPCId] = &vpc.VPCInfo{
+		VPCId:       fakeAPIDefaultVPCId,
+		Name:        "DefaultVPC",
+		Tag:         defaultTag,
+		Network:     []string{"10.9.0.0/16"},
+		NetworkInfo: []vpc.VPCNetworkInfo{{Network: "10.9.0.0/16"}},
+		CreateTime:  api.now(),
+		UpdateTime:  api.now(),
+	}
+
+	api.vpc.subnets[fakeAPIDefaultSubnetId] = &vpc.VPCSubnetInfoSet{
+		SubnetId:   fakeAPIDefaultSubnetId,
+		VPCId:      fakeAPIDefaultVPCId,
+		VPCName:    "DefaultVPC",
+		SubnetName: "DefaultNetwork",
+		Name:       "DefaultNetwork",
+		Tag:        defaultTag,
+		Subnet:     "10.9.0.0",
+		Netmask:    "16",
+		Gateway:    "10.9.0.1",
+		CreateTime: api.now(),
+	}
+
+	api.register("CreateVPC", api.createVPC)
+	api.register("DescribeVPC", api.describeVPC)
+	api.register("DeleteVPC", api.deleteVPC)
+	api.register("CreateSubnet", api.createSubnet)
+	api.register("DescribeSubnet", api.describeSubnet)
+	api.register("DescribeSubnetResource", api.describeSubnetResource)
+	api.register("UpdateSubnetAttribute", api.updateSubnetAttribute)
+	api.register("DeleteSubnet", api.deleteSubnet)
+	api.register("CreateVPCIntercom", api.createVPCIntercom)
+	api.register("DescribeVPCIntercom", api.describeVPCIntercom)
+	api.register("DeleteVPCIntercom", api.deleteVPCIntercom)
+}
+
+func (api *fakeUCloudAPI) createVPC(q fakeQuery) (interface{}, error) {
+	vpcSet := &vpc.VPCInfo{
+		VPCId:      api.newId("uvnet"),
+		Name:       q.str("Name"),
+		Tag:        q.strOr("Tag", defaultTag),
+		Network:    q.list("Network"),
+		CreateTime: api.now(),
+		UpdateTime: api.now(),
+	}
+
+	for _, network := range vpcSet.Network {
+		vpcSet.NetworkInfo = append(vpcSet.NetworkInfo, vpc.VPCNetworkInfo{Network: network})
+	}
+
+	api.vpc.vpcs[vpcSet.VPCId] = vpcSet
+	return &vpc.CreateVPCResponse{VPCId: vpcSet.VPCId}, nil
+}
+
+func (api *fakeUCloudAPI) describeVPC(q fakeQuery) (interface{}, error) {
+	ids := q.list("VPCIds")
+
+	resp := &vpc.DescribeVPCResponse{}
+	for _, vpcSet := range api.vpc.vpcs {
+		if len(ids) > 0 && !isStringIn(vpcSet.VPCId, ids) {
+			continue
+		}
+
+		if v := q.str("Tag"); v != "" && vpcSet.Tag != v {
+			continue
+		}
+
+		item := *vpcSet
+		item.SubnetCount = 0
+		for _, subnet := range api.vpc.subnets {
+			if subnet.VPCId == item.VPCId {
+				item.SubnetCount++
+			}
+		}
+		resp.DataSet = append(resp.DataSet, item)
+	}
+	return resp, nil
+}
+
+func (api *fakeUCloudAPI) deleteVPC(q fakeQuery) (interface{}, error) {
+	vpcId := q.str("VPCId")
+	if _, ok := api.vpc.vpcs[vpcId]; !ok {
+		return nil, newFakeAPIError(58002, "vpc %s is not found", vpcId)
+	}
+
+	for _, subnet := range api.vpc.subnets {
+		if subnet.VPCId == vpcId {
+			return nil, newFakeAPIError(58003, "vpc %s has subnet %s", vpcId, subnet.SubnetId)
+		}
+	}
+
+	intercoms := []fakeVPCIntercom{}
+	for _, item := range api.vpc.intercoms {
+		if item.vpcId != vpcId && item.dstVPCId != vpcId {
+			intercoms = append(intercoms, item)
+		}
+	}
+	api.vpc.intercoms = intercoms
+
+	delete(api.vpc.vpcs, vpcId)
+	return &vpc.DeleteVPCResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) createSubnet(q fakeQuery) (interface{}, error) {
+	vpcSet, ok := api.vpc.vpcs[q.str("VPCId")]
+	if !ok {
+		return nil, newFakeAPIError(58002, "vpc %s is not found", q.str("VPCId"))
+	}
+
+	subnet := &vpc.VPCSubnetInfoSet{
+		SubnetId:   api.newId("subnet"),
+		VPCId:      vpcSet.VPCId,
+		VPCName:    vpcSet.Name,
+		SubnetName: q.str("SubnetName"),
+		Name:       q.str("SubnetName"),
+		Tag:        q.strOr("Tag", defaultTag),
+		Remark:     q.str("Remark"),
+		Subnet:     q.str("Subnet"),
+		Netmask:    strconv.Itoa(q.intOr("Netmask", 24)),
+		CreateTime: api.now(),
+	}
+
+	cidr, err := parseCidrBlock(fmt.Sprintf("%s/%s", subnet.Subnet, subnet.Netmask))
+	if err != nil {
+		return nil, newFakeAPIError(58004, "subnet %s is invalid, %s", subnet.Subnet, err)
+	}
+	subnet.Gateway = cidr.Network
+
+	api.vpc.subnets[subnet.SubnetId] = subnet
+	return &vpc.CreateSubnetResponse{SubnetId: subnet.SubnetId}, nil
+}
+
+// allocateSubnetIP will returns the next ip of subnet which is not used by instances and vips,
+// the first two addresses are reserved by network and gateway.
+func (api *fakeUCloudAPI) allocateSubnetIP(subnet *vpc.VPCSubnetInfoSet) (string, error) {
+	used := 0
+	for _, instance := range api.uhost.instances {
+		for _, item := range instance.IPSet {
+			if item.SubnetId == subnet.SubnetId {
+				used++
+			}
+		}
+	}
+
+	for _, vip := range api.unet.vips {
+		if vip.SubnetId == subnet.SubnetId {
+			used++
+		}
+	}
+
+	ip := net.ParseIP(subnet.Subnet).To4()
+	if ip == nil {
+		return "", newFakeAPIError(58004, "subnet %s is invalid", subnet.Subnet)
+	}
+
+	ip = net.IPv4(ip[0], ip[1], ip[2]+byte((used+2)/256), ip[3]+byte((used+2)%256))
+	return ip.String(), nil
+}
+
+func (api *fakeUCloudAPI) describeSubnet(q fakeQuery) (interface{}, error) {
+	ids := q.list("SubnetIds")
+	if v := q.str("SubnetId"); v != "" {
+		ids = append(ids, v)
+	}
+
+	subnets := []vpc.VPCSubnetInfoSet{}
+	for _, subnet := range api.vpc.subnets {
+		if len(ids) > 0 && !isStringIn(subnet.SubnetId, ids) {
+			continue
+		}
+
+		if v := q.str("VPCId"); v != "" && subnet.VPCId != v {
+			continue
+		}
+
+		if v := q.str("Tag"); v != "" && subnet.Tag != v {
+			continue
+		}
+
+		subnets = append(subnets, *subnet)
+	}
+
+	start, end := q.page(len(subnets))
+	return &vpc.DescribeSubnetResponse{
+		TotalCount: len(subnets),
+		DataSet:    subnets[start:end],
+	}, nil
+}
+
+// describeSubnetResource will returns the instances, vips and load balancers which are located in the subnet
+func (api *fakeUCloudAPI) describeSubnetResource(q fakeQuery) (interface{}, error) {
+	subnetId := q.str("SubnetId")
+	if _, ok := api.vpc.subnets[subnetId]; !ok {
+		return nil, newFakeAPIError(58005, "subnet %s is not found", subnetId)
+	}
+
+	resources := []vpc.ResourceInfo{}
+	for _, instance := range api.uhost.instances {
+		for _, item := range instance.IPSet {
+			if item.SubnetId == subnetId {
+				resources = append(resources, vpc.ResourceInfo{
+					Name:         instance.Name,
+					ResourceId:   instance.UHostId,
+					ResourceType: "uhost",
+					IP:           item.IP,
+				})
+			}
+		}
+	}
+
+	for _, vip := range api.unet.vips {
+		if vip.SubnetId == subnetId {
+			resources = append(resources, vpc.ResourceInfo{
+				Name:         vip.Name,
+				ResourceId:   vip.VIPId,
+				ResourceType: "vip",
+				IP:           vip.VIP,
+			})
+		}
+	}
+
+	for _, lb := range api.ulb.lbs {
+		if lb.SubnetId == subnetId {
+			resources = append(resources, vpc.ResourceInfo{
+				Name:         lb.Name,
+				ResourceId:   lb.ULBId,
+				ResourceType: "ulb",
+			})
+		}
+	}
+
+	start, end := q.page(len(resources))
+	return &vpc.DescribeSubnetResourceResponse{
+		TotalCount: len(resources),
+		DataSet:    resources[start:end],
+	}, nil
+}
+
+func (api *fakeUCloudAPI) updateSubnetAttribute(q fakeQuery) (interface{}, error) {
+	subnet, ok := api.vpc.subnets[q.str("SubnetId")]
+	if !ok {
+		return nil, newFakeAPIError(58005, "subnet %s is not found", q.str("SubnetId"))
+	}
+
+	if v := q.str("Name"); v != "" {
+		subnet.Name = v
+		subnet.SubnetName = v
+	}
+
+	if v := q.str("Tag"); v != "" {
+		subnet.Tag = v
+	}
+
+	return &vpc.UpdateSubnetAttributeResponse{}, nil
+}
+
+func (api *fakeUCloudAPI) deleteSubnet(q fakeQuery) (interface{}, error) {
+	subnetId := q.str("SubnetId")
+	if _, ok := api.vpc.subnets[subnetId]; !ok {
+		return nil, newFakeAPIError(58005, "subnet %s is not found", subnetId)
+	}
+
+	delete(api.vpc.subnets, subnetId)
+	return &vpc.DeleteSubnetResponse{}, nil
+}
+
+// newVPCIntercom will returns the peering connection of request, the destination is the region and project of request by default
+func (api *fakeUCloudAPI) newVPCIntercom(q fakeQuery) fakeVPCIntercom {
+	return fakeVPCIntercom{
+		vpcId:        q.str("VPCId"),
+		dstVPCId:     q.str("DstVPCId"),
+		dstRegion:    q.strOr("DstRegion", q.strOr("Region", fakeAPIRegion)),
+		dstProjectId: q.strOr("DstProjectId", q.strOr("ProjectId", fakeAPIProjectId)),
+	}
+}
+
+func (api *fakeUCloudAPI) createVPCIntercom(q fakeQuery) (interface{}, error) {
+	intercom := api.newVPCIntercom(q)
+	for _, vpcId := range []string{intercom.vpcId, intercom.dstVPCId} {
+		if _, ok := api.vpc.vpcs[vpcId]; !ok {
+			return nil, newFakeAPIError(58002, "vpc %s is not found", vpcId)
+		}
+	}
+
+	for _, item := range api.vpc.intercoms {
+		if item == intercom {
+			return nil, newFakeAPIError(58104, "vpc %s is already connected to vpc %s", intercom.vpcId, intercom.dstVPCId)
+		}
+	}
+
+	api.vpc.intercoms = append(api.vpc.intercoms, intercom)
+	return &vpc.CreateVPCIntercomResponse{}, nil
+}
+
+// describeVPCIntercom will returns the peer vpcs of the vpc in destination region and project
+func (api *fakeUCloudAPI) describeVPCIntercom(q fakeQuery) (interface{}, error) {
+	intercom := api.newVPCIntercom(q)
+	if _, ok := api.vpc.vpcs[intercom.vpcId]; !ok {
+		return nil, newFakeAPIError(58103, "vpc %s is not found", intercom.vpcId)
+	}
+
+	resp := &vpc.DescribeVPCIntercomResponse{}
+	for _, item := range api.vpc.intercoms {
+		if item.vpcId != intercom.vpcId || item.dstRegion != intercom.dstRegion || item.dstProjectId != intercom.dstProjectId {
+			continue
+		}
+
+		peer, ok := api.vpc.vpcs[item.dstVPCId]
+		if !ok {
+			continue
+		}
+
+		resp.DataSet = append(resp.DataSet, vpc.VPCIntercomInfo{
+			VPCId:     peer.VPCId,
+			Name:      peer.Name,
+			Tag:       peer.Tag,
+			Network:   peer.Network,
+			DstRegion: item.dstRegion,
+			ProjectId: item.dstProjectId,
+		})
+	}
+	return resp, nil
+}
+
+func (api *fakeUCloudAPI) deleteVPCIntercom(q fakeQuery) (interface{}, error) {
+	intercom := api.newVPCIntercom(q)
+	for i, item := range api.vpc.intercoms {
+		if item == intercom {
+			api.vpc.intercoms = append(api.vpc.intercoms[:i], api.vpc.intercoms[i+1:]...)
+			return &vpc.DeleteVPCIntercomResponse{}, nil
+		}
+	}
+
+	return nil, newFakeAPIError(58105, "vpc %s is not connected to vpc %s", intercom.vpcId, intercom.dstVPCId)
+}
@@ -7,6 +7,8 @@ import (
 )
 
 func TestAccUCloudDisk_import(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	resourceName := "ucloud_disk.foo"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -0,0 +1,30 @@
+package ucloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudEIPBandwidthPackage_import(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	resourceName := "ucloud_eip_bandwidth_package.foo"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEIPBandwidthPackageDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEIPBandwidthPackageConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -7,6 +7,8 @@ import (
 )
 
 func TestAccUCloudEIP_import(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	resourceName := "ucloud_eip.foo"
 
 	resource.ParallelTest(t, resource.TestCase{
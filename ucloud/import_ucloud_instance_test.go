@@ -8,6 +8,8 @@ import (
 )
 
 func TestAccUCloudInstance_import(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	rInt := acctest.RandInt()
 	resourceName := "ucloud_instance.foo"
 
@@ -33,6 +35,7 @@ func TestAccUCloudInstance_import(t *testing.T) {
 					"duration",
 					"data_disk_type",
 					"boot_disk_type",
+					"reserve_data_disk",
 				},
 			},
 		},
@@ -7,6 +7,8 @@ import (
 )
 
 func TestAccUCloudLB_import(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	resourceName := "ucloud_lb.foo"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -8,6 +8,8 @@ import (
 )
 
 func TestAccUCloudSecurityGroup_import(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	rInt := acctest.RandInt()
 	resourceName := "ucloud_security_group.foo"
 
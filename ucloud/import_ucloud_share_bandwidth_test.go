@@ -0,0 +1,31 @@
+package ucloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudShareBandwidth_import(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	resourceName := "ucloud_share_bandwidth.foo"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckShareBandwidthDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccShareBandwidthConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"duration", "eip_bandwidth"},
+			},
+		},
+	})
+}
@@ -7,6 +7,8 @@ import (
 )
 
 func TestAccUCloudSubnet_import(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	resourceName := "ucloud_subnet.foo"
 
 	resource.ParallelTest(t, resource.TestCase{
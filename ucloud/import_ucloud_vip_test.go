@@ -0,0 +1,30 @@
+package ucloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccUCloudVIP_import(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	resourceName := "ucloud_vip.foo"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVIPDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVIPConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -7,6 +7,8 @@ import (
 )
 
 func TestAccUCloudVPC_import(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	resourceName := "ucloud_vpc.foo"
 
 	resource.ParallelTest(t, resource.TestCase{
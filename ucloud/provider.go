@@ -1,43 +1,75 @@
 package ucloud
 
 import (
+	"log"
+
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"public_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("UCLOUD_PUBLIC_KEY", nil),
 				Description: descriptions["public_key"],
 			},
 
 			"private_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("UCLOUD_PRIVATE_KEY", nil),
 				Description: descriptions["private_key"],
 			},
 
 			"region": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("UCLOUD_REGION", nil),
 				Description: descriptions["region"],
 			},
 
 			"project_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("UCLOUD_PROJECT_ID", nil),
 				Description: descriptions["project_id"],
 			},
 
+			"shared_credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("UCLOUD_SHARED_CREDENTIALS_FILE", defaultSharedCredentialsFile),
+				Description: descriptions["shared_credentials_file"],
+			},
+
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("UCLOUD_PROFILE", defaultProfile),
+				Description: descriptions["profile"],
+			},
+
+			"base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("UCLOUD_BASE_URL", ""),
+				Description: descriptions["base_url"],
+			},
+
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        endpointsSchema(),
+				Description: descriptions["endpoints"],
+			},
+
 			"max_retries": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -45,6 +77,40 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["max_retries"],
 			},
 
+			"max_requests_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["max_requests_per_second"],
+			},
+
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["max_concurrent_requests"],
+			},
+
+			"log_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("UCLOUD_LOG_FILE", ""),
+				Description: descriptions["log_file"],
+			},
+
+			"log_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  auditLogFormatText,
+				ValidateFunc: validation.StringInSlice([]string{
+					auditLogFormatText,
+					auditLogFormatJSON,
+				}, false),
+				Description: descriptions["log_format"],
+			},
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -54,47 +120,132 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"ucloud_projects": dataSourceUCloudProjects(),
-			"ucloud_images":   dataSourceUCloudImages(),
-			"ucloud_zones":    dataSourceUCloudZones(),
-			"ucloud_eips":     dataSourceUCloudEips(),
+			"ucloud_projects":        dataSourceUCloudProjects(),
+			"ucloud_images":          dataSourceUCloudImages(),
+			"ucloud_zones":           dataSourceUCloudZones(),
+			"ucloud_eips":            dataSourceUCloudEips(),
+			"ucloud_disk_snapshots":  dataSourceUCloudDiskSnapshots(),
+			"ucloud_vips":            dataSourceUCloudVIPs(),
+			"ucloud_instance_price":  dataSourceUCloudInstancePrice(),
+			"ucloud_disk_price":      dataSourceUCloudDiskPrice(),
+			"ucloud_eip_price":       dataSourceUCloudEIPPrice(),
+			"ucloud_instances":       dataSourceUCloudInstances(),
+			"ucloud_disks":           dataSourceUCloudDisks(),
+			"ucloud_vpcs":            dataSourceUCloudVPCs(),
+			"ucloud_subnets":         dataSourceUCloudSubnets(),
+			"ucloud_security_groups": dataSourceUCloudSecurityGroups(),
+			"ucloud_lbs":             dataSourceUCloudLBs(),
+			"ucloud_lb_listeners":    dataSourceUCloudLBListeners(),
+			"ucloud_lb_attachments":  dataSourceUCloudLBAttachments(),
+			"ucloud_lb_rules":        dataSourceUCloudLBRules(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"ucloud_instance":               resourceUCloudInstance(),
-			"ucloud_eip":                    resourceUCloudEIP(),
-			"ucloud_eip_association":        resourceUCloudEIPAssociation(),
-			"ucloud_vpc":                    resourceUCloudVPC(),
-			"ucloud_subnet":                 resourceUCloudSubnet(),
-			"ucloud_vpc_peering_connection": resourceUCloudVPCPeeringConnection(),
-			"ucloud_lb":                     resourceUCloudLB(),
-			"ucloud_lb_listener":            resourceUCloudLBListener(),
-			"ucloud_lb_attachment":          resourceUCloudLBAttachment(),
-			"ucloud_lb_rule":                resourceUCloudLBRule(),
-			"ucloud_disk":                   resourceUCloudDisk(),
-			"ucloud_disk_attachment":        resourceUCloudDiskAttachment(),
-			"ucloud_security_group":         resourceUCloudSecurityGroup(),
+			"ucloud_instance":                    resourceUCloudInstance(),
+			"ucloud_eip":                         resourceUCloudEIP(),
+			"ucloud_eip_association":             resourceUCloudEIPAssociation(),
+			"ucloud_vpc":                         resourceUCloudVPC(),
+			"ucloud_subnet":                      resourceUCloudSubnet(),
+			"ucloud_vpc_peering_connection":      resourceUCloudVPCPeeringConnection(),
+			"ucloud_lb":                          resourceUCloudLB(),
+			"ucloud_lb_listener":                 resourceUCloudLBListener(),
+			"ucloud_lb_attachment":               resourceUCloudLBAttachment(),
+			"ucloud_lb_rule":                     resourceUCloudLBRule(),
+			"ucloud_disk":                        resourceUCloudDisk(),
+			"ucloud_disk_attachment":             resourceUCloudDiskAttachment(),
+			"ucloud_security_group":              resourceUCloudSecurityGroup(),
+			"ucloud_security_group_rule":         resourceUCloudSecurityGroupRule(),
+			"ucloud_security_group_attachment":   resourceUCloudSecurityGroupAttachment(),
+			"ucloud_custom_image":                resourceUCloudCustomImage(),
+			"ucloud_image_copy":                  resourceUCloudImageCopy(),
+			"ucloud_disk_snapshot":               resourceUCloudDiskSnapshot(),
+			"ucloud_eip_bandwidth_package":       resourceUCloudEIPBandwidthPackage(),
+			"ucloud_share_bandwidth":             resourceUCloudShareBandwidth(),
+			"ucloud_share_bandwidth_association": resourceUCloudShareBandwidthAssociation(),
+			"ucloud_vip":                         resourceUCloudVIP(),
 		},
-		ConfigureFunc: providerConfigure,
 	}
+
+	// the client replaced by configuring the provider again is closed, so that its log file is not leaked
+	var client *UCloudClient
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		newClient, err := providerConfigure(d)
+		if err != nil {
+			return nil, err
+		}
+
+		if client != nil {
+			if err := client.close(); err != nil {
+				log.Printf("[WARN] error on closing the client of provider, %s", err)
+			}
+		}
+
+		client = newClient
+		return client, nil
+	}
+
+	return provider
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData) (*UCloudClient, error) {
 	config := Config{
-		PublicKey:  d.Get("public_key").(string),
-		PrivateKey: d.Get("private_key").(string),
-		Region:     d.Get("region").(string),
-		MaxRetries: d.Get("max_retries").(int),
-		Insecure:   d.Get("insecure").(bool),
+		PublicKey:             d.Get("public_key").(string),
+		PrivateKey:            d.Get("private_key").(string),
+		Region:                d.Get("region").(string),
+		SharedCredentialsFile: d.Get("shared_credentials_file").(string),
+		Profile:               d.Get("profile").(string),
+		MaxRetries:            d.Get("max_retries").(int),
+		MaxRequestsPerSecond:  d.Get("max_requests_per_second").(int),
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		Insecure:              d.Get("insecure").(bool),
+		LogFile:               d.Get("log_file").(string),
+		LogFormat:             d.Get("log_format").(string),
+		BaseUrl:               d.Get("base_url").(string),
+		Endpoints:             map[string]string{},
+	}
+
+	if v, ok := d.GetOk("endpoints"); ok {
+		for _, raw := range v.([]interface{}) {
+			// the block may be empty
+			endpoints, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, product := range products {
+				config.Endpoints[product] = endpoints[product].(string)
+			}
+		}
 	}
 
 	if projectId, ok := d.GetOk("project_id"); ok && projectId.(string) != "" {
 		config.ProjectId = projectId.(string)
 	}
 
+	// the arguments which are not set by hcl or environment variables are loaded from profile
+	if err := config.loadSharedCredentials(); err != nil {
+		return nil, err
+	}
+
 	client, err := config.Client()
 	return client, err
 }
 
+func endpointsSchema() *schema.Resource {
+	endpoints := map[string]*schema.Schema{}
+	for _, product := range products {
+		endpoints[product] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: descriptions["endpoint"],
+		}
+	}
+
+	return &schema.Resource{
+		Schema: endpoints,
+	}
+}
+
 var ucloudMutexKV = mutexkv.NewMutexKV()
 
 var descriptions map[string]string
@@ -105,7 +256,19 @@ func init() {
 		"private_key": "...",
 		"region":      "...",
 		"project_id":  "...",
-		"max_retries": "...",
-		"insecure":    "...",
+
+		"shared_credentials_file": "...",
+		"profile":                 "...",
+
+		"base_url":  "...",
+		"endpoints": "...",
+		"endpoint":  "...",
+
+		"max_retries":             "...",
+		"max_requests_per_second": "...",
+		"max_concurrent_requests": "...",
+		"log_file":                "...",
+		"log_format":              "...",
+		"insecure":                "...",
 	}
 }
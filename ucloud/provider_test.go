@@ -32,6 +32,9 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
+	startFakeAPIIfEnabled()
+	startCassetteIfEnabled(t)
+
 	if v := os.Getenv("UCLOUD_PUBLIC_KEY"); v == "" {
 		t.Fatal("UCLOUD_PUBLIC_KEY must be set for acceptance tests")
 	}
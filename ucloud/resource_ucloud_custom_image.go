@@ -0,0 +1,209 @@
+package ucloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func resourceUCloudCustomImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUCloudCustomImageCreate,
+		Read:   resourceUCloudCustomImageRead,
+		Delete: resourceUCloudCustomImageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      resource.PrefixedUniqueId("tf-custom-image-"),
+				ValidateFunc: validateName,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"os_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUCloudCustomImageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.uhostconn
+
+	instanceId := d.Get("instance_id").(string)
+	instance, err := client.describeInstanceById(instanceId)
+	if err != nil {
+		return fmt.Errorf("error on reading instance %s when creating custom image, %s", instanceId, err)
+	}
+
+	req := conn.NewCreateCustomImageRequest()
+	req.Zone = ucloud.String(instance.Zone)
+	req.UHostId = ucloud.String(instanceId)
+	req.ImageName = ucloud.String(d.Get("name").(string))
+
+	if v, ok := d.GetOk("description"); ok {
+		req.ImageDescription = ucloud.String(v.(string))
+	}
+
+	resp, err := conn.CreateCustomImage(req)
+	if err != nil {
+		return fmt.Errorf("error on creating custom image, %s", err)
+	}
+
+	d.SetId(resp.ImageId)
+
+	// after create custom image, we need to wait it available
+	stateConf := imageWaitForState(client, d.Id(), client.region, client.projectId, d.Timeout(schema.TimeoutCreate))
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for custom image %s complete creating, %s", d.Id(), err)
+	}
+
+	return resourceUCloudCustomImageRead(d, meta)
+}
+
+func resourceUCloudCustomImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	imageSet, err := client.describeImageByRegion(d.Id(), client.region, client.projectId)
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading custom image %s, %s", d.Id(), err)
+	}
+
+	d.Set("name", imageSet.ImageName)
+	d.Set("description", imageSet.ImageDescription)
+	d.Set("availability_zone", imageSet.Zone)
+	d.Set("type", upperCamelCvt.convert(imageSet.ImageType))
+	d.Set("size", imageSet.ImageSize)
+	d.Set("os_type", upperCamelCvt.convert(imageSet.OsType))
+	d.Set("os_name", imageSet.OsName)
+	d.Set("status", imageSet.State)
+	d.Set("create_time", timestampToString(imageSet.CreateTime))
+
+	return nil
+}
+
+func resourceUCloudCustomImageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	return deleteCustomImage(client, d.Id(), client.region, client.projectId, d.Timeout(schema.TimeoutDelete))
+}
+
+// deleteCustomImage will terminate the custom image in the specified region and project,
+// and wait for it to be deleted.
+func deleteCustomImage(client *UCloudClient, imageId, region, projectId string, timeout time.Duration) error {
+	conn := client.uhostconn
+
+	req := conn.NewTerminateCustomImageRequest()
+	req.ImageId = ucloud.String(imageId)
+	req.SetRegion(region)
+	req.SetProjectId(projectId)
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		if _, err := conn.TerminateCustomImage(req); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error on deleting custom image %s, %s", imageId, err))
+		}
+
+		_, err := client.describeImageByRegion(imageId, region, projectId)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("error on reading custom image when deleting %s, %s", imageId, err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("the specified custom image %s has not been deleted due to unknown error", imageId))
+	})
+}
+
+func imageWaitForState(client *UCloudClient, imageId, region, projectId string, timeout time.Duration) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending:    []string{statusPending},
+		Target:     []string{"available"},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			imageSet, err := client.describeImageByRegion(imageId, region, projectId)
+			if err != nil {
+				if isNotFoundError(err) {
+					return nil, statusPending, nil
+				}
+				return nil, "", err
+			}
+
+			state := strings.ToLower(imageSet.State)
+			if state == "unavailable" {
+				return nil, "", fmt.Errorf("the specified image %s is unavailable", imageId)
+			}
+
+			if state != "available" {
+				state = statusPending
+			}
+
+			return imageSet, state, nil
+		},
+	}
+}
@@ -0,0 +1,164 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/ucloud/ucloud-sdk-go/services/uhost"
+)
+
+func init() {
+	resource.AddTestSweepers("ucloud_custom_image", &resource.Sweeper{
+		Name: "ucloud_custom_image",
+		Dependencies: []string{
+			"ucloud_image_copy",
+		},
+		F: testSweepCustomImages,
+	})
+}
+
+func testSweepCustomImages(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	images, err := testSweepListCustomImages(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range images {
+		log.Printf("[INFO] Destroying custom image %s (%s)", item.ImageId, item.ImageName)
+		if err := testSweepDeleteResource(client, resourceUCloudCustomImage(), item.ImageId, nil); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
+func TestAccUCloudCustomImage_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+	var imageSet uhost.UHostImageSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_custom_image.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckCustomImageDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCustomImageConfig(rInt),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomImageExists("ucloud_custom_image.foo", &imageSet),
+					resource.TestCheckResourceAttr("ucloud_custom_image.foo", "name", "tf-acc-custom-image"),
+					resource.TestCheckResourceAttr("ucloud_custom_image.foo", "description", "tf-acc-custom-image"),
+					resource.TestCheckResourceAttr("ucloud_custom_image.foo", "type", "custom"),
+					resource.TestCheckResourceAttr("ucloud_custom_image.foo", "status", "Available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomImageExists(n string, imageSet *uhost.UHostImageSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("custom image id is empty")
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		ptr, err := client.describeImageByRegion(rs.Primary.ID, client.region, client.projectId)
+
+		log.Printf("[INFO] custom image id %#v", rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*imageSet = *ptr
+		return nil
+	}
+}
+
+func testAccCheckCustomImageDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_custom_image" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		imageSet, err := client.describeImageByRegion(rs.Primary.ID, client.region, client.projectId)
+
+		// Verify the error is what we want
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if imageSet.ImageId != "" {
+			return fmt.Errorf("custom image still exist")
+		}
+	}
+
+	return nil
+}
+
+func testAccCustomImageConfig(rInt int) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_security_group" "default" {
+  name = "tf-acc-custom-image-%d"
+  tag  = "tf-acc"
+
+  rules {
+    port_range = "80"
+    protocol   = "tcp"
+    cidr_block = "192.168.0.0/16"
+    policy     = "accept"
+  }
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  security_group    = "${ucloud_security_group.default.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-custom-image"
+  tag               = "tf-acc"
+}
+
+resource "ucloud_custom_image" "foo" {
+  instance_id = "${ucloud_instance.foo.id}"
+  name        = "tf-acc-custom-image"
+  description = "tf-acc-custom-image"
+}`, rInt)
+}
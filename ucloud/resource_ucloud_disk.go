@@ -65,6 +65,26 @@ func resourceUCloudDisk() *schema.Resource {
 				ValidateFunc: validateDuration,
 			},
 
+			"data_ark": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"snapshot_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_disk_id"},
+			},
+
+			"source_disk_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id"},
+			},
+
 			"tag": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -93,6 +113,21 @@ func resourceUCloudDisk() *schema.Resource {
 }
 
 func resourceUCloudDiskCreate(d *schema.ResourceData, meta interface{}) error {
+	// the cloned disk will always be assigned default tag
+	_, hasSnapshot := d.GetOk("snapshot_id")
+	_, hasSourceDisk := d.GetOk("source_disk_id")
+	if (hasSnapshot || hasSourceDisk) && stateFuncTag(d.Get("tag")) != defaultTag {
+		return fmt.Errorf("error on creating disk, tag is not supported when creating disk from snapshot_id or source_disk_id")
+	}
+
+	if hasSnapshot {
+		return resourceUCloudDiskCreateBySnapshot(d, meta)
+	}
+
+	if hasSourceDisk {
+		return resourceUCloudDiskCreateByDisk(d, meta)
+	}
+
 	client := meta.(*UCloudClient)
 	conn := client.udiskconn
 
@@ -100,9 +135,10 @@ func resourceUCloudDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	req.Name = ucloud.String(d.Get("name").(string))
 	req.Zone = ucloud.String(d.Get("availability_zone").(string))
 	req.Size = ucloud.Int(d.Get("disk_size").(int))
-	req.DiskType = ucloud.String(upperCamelCvt.unconvert(d.Get("disk_type").(string)))
+	req.DiskType = ucloud.String(diskTypeCvt.convert(d.Get("disk_type").(string)))
 	req.ChargeType = ucloud.String(upperCamelCvt.unconvert(d.Get("charge_type").(string)))
 	req.Quantity = ucloud.Int(d.Get("duration").(int))
+	req.UDataArkMode = ucloud.String(boolCamelCvt.convert(d.Get("data_ark").(bool)))
 
 	// if tag is empty string, use default tag
 	if v, ok := d.GetOk("tag"); ok {
@@ -130,6 +166,105 @@ func resourceUCloudDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceUCloudDiskRead(d, meta)
 }
 
+// resourceUCloudDiskCreateBySnapshot will create disk by cloning from the disk snapshot
+func resourceUCloudDiskCreateBySnapshot(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.udiskconn
+
+	req := conn.NewCloneUDiskSnapshotRequest()
+	req.Name = ucloud.String(d.Get("name").(string))
+	req.Zone = ucloud.String(d.Get("availability_zone").(string))
+	req.SourceId = ucloud.String(d.Get("snapshot_id").(string))
+	req.Size = ucloud.Int(d.Get("disk_size").(int))
+	req.ChargeType = ucloud.String(upperCamelCvt.unconvert(d.Get("charge_type").(string)))
+	req.Quantity = ucloud.Int(d.Get("duration").(int))
+	req.UDataArkMode = ucloud.String(boolCamelCvt.convert(d.Get("data_ark").(bool)))
+
+	resp, err := conn.CloneUDiskSnapshot(req)
+	if err != nil {
+		return fmt.Errorf("error on creating disk from snapshot, %s", err)
+	}
+
+	if len(resp.UDiskId) != 1 {
+		return fmt.Errorf("error on creating disk from snapshot, expected exactly one disk, got %v", len(resp.UDiskId))
+	}
+
+	d.SetId(resp.UDiskId[0])
+
+	// after clone disk, we need to wait it available
+	stateConf := diskWaitForState(client, d.Id())
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for disk %s complete creating, %s", d.Id(), err)
+	}
+
+	return resourceUCloudDiskRead(d, meta)
+}
+
+// resourceUCloudDiskCreateByDisk will create disk by cloning from another disk,
+// the disk will be resized after cloned if disk size is larger than the source disk.
+func resourceUCloudDiskCreateByDisk(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.udiskconn
+
+	sourceDiskId := d.Get("source_disk_id").(string)
+	sourceDisk, err := client.describeDiskById(sourceDiskId)
+	if err != nil {
+		return fmt.Errorf("error on reading source disk %s when creating disk, %s", sourceDiskId, err)
+	}
+
+	diskSize := d.Get("disk_size").(int)
+	if diskSize < sourceDisk.Size {
+		return fmt.Errorf("expected disk_size to be at least %d as the size of source disk %s", sourceDisk.Size, sourceDiskId)
+	}
+
+	req := conn.NewCloneUDiskRequest()
+	req.Name = ucloud.String(d.Get("name").(string))
+	req.Zone = ucloud.String(d.Get("availability_zone").(string))
+	req.SourceId = ucloud.String(sourceDiskId)
+	req.ChargeType = ucloud.String(upperCamelCvt.unconvert(d.Get("charge_type").(string)))
+	req.Quantity = ucloud.Int(d.Get("duration").(int))
+	req.UDataArkMode = ucloud.String(boolCamelCvt.convert(d.Get("data_ark").(bool)))
+
+	resp, err := conn.CloneUDisk(req)
+	if err != nil {
+		return fmt.Errorf("error on creating disk from source disk, %s", err)
+	}
+
+	if len(resp.UDiskId) != 1 {
+		return fmt.Errorf("error on creating disk from source disk, expected exactly one disk, got %v", len(resp.UDiskId))
+	}
+
+	d.SetId(resp.UDiskId[0])
+
+	// after clone disk, we need to wait it available
+	stateConf := diskWaitForState(client, d.Id())
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for disk %s complete creating, %s", d.Id(), err)
+	}
+
+	if diskSize > sourceDisk.Size {
+		resizeReq := conn.NewResizeUDiskRequest()
+		resizeReq.Zone = ucloud.String(d.Get("availability_zone").(string))
+		resizeReq.UDiskId = ucloud.String(d.Id())
+		resizeReq.Size = ucloud.Int(diskSize)
+
+		if _, err := conn.ResizeUDisk(resizeReq); err != nil {
+			return fmt.Errorf("error on %s to disk %s, %s", "ResizeUDisk", d.Id(), err)
+		}
+
+		// after update disk size, we need to wait it completed
+		stateConf := diskWaitForState(client, d.Id())
+
+		if _, err = stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error on waiting for %s complete to disk %s, %s", "ResizeUDisk", d.Id(), err)
+		}
+	}
+
+	return resourceUCloudDiskRead(d, meta)
+}
+
 func resourceUCloudDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*UCloudClient)
 	conn := client.udiskconn
@@ -171,6 +306,27 @@ func resourceUCloudDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("data_ark") && !d.IsNewResource() {
+		req := conn.NewSetUDiskUDataArkModeRequest()
+		req.Zone = ucloud.String(d.Get("availability_zone").(string))
+		req.UDiskId = ucloud.String(d.Id())
+		req.UDataArkMode = ucloud.String(boolCamelCvt.convert(d.Get("data_ark").(bool)))
+
+		_, err := conn.SetUDiskUDataArkMode(req)
+		if err != nil {
+			return fmt.Errorf("error on %s to disk %s, %s", "SetUDiskUDataArkMode", d.Id(), err)
+		}
+
+		d.SetPartial("data_ark")
+
+		// after update disk data ark mode, we need to wait it completed
+		stateConf := diskDataArkWaitForState(client, d.Id(), d.Get("data_ark").(bool))
+
+		if _, err = stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error on waiting for %s complete to disk %s, %s", "SetUDiskUDataArkMode", d.Id(), err)
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceUCloudDiskRead(d, meta)
@@ -197,6 +353,7 @@ func resourceUCloudDiskRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("create_time", timestampToString(diskSet.CreateTime))
 	d.Set("expire_time", timestampToString(diskSet.ExpiredTime))
 	d.Set("status", diskSet.Status)
+	d.Set("data_ark", boolCamelCvt.unconvert(diskSet.UDataArkMode))
 
 	return nil
 }
@@ -251,3 +408,31 @@ func diskWaitForState(client *UCloudClient, diskId string) *resource.StateChange
 		},
 	}
 }
+
+// diskDataArkWaitForState will wait until the data ark mode of disk is changed,
+// the disk may be attached to an instance, so both available and in use are regarded as completed.
+func diskDataArkWaitForState(client *UCloudClient, diskId string, dataArk bool) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending:    []string{statusPending},
+		Target:     []string{statusInitialized},
+		Timeout:    10 * time.Minute,
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			diskSet, err := client.describeDiskById(diskId)
+			if err != nil {
+				if isNotFoundError(err) {
+					return nil, statusPending, nil
+				}
+				return nil, "", err
+			}
+
+			state := strings.ToLower(diskSet.Status)
+			if (state != "available" && state != "inuse") || boolCamelCvt.unconvert(diskSet.UDataArkMode) != dataArk {
+				return diskSet, statusPending, nil
+			}
+
+			return diskSet, statusInitialized, nil
+		},
+	}
+}
@@ -2,6 +2,7 @@ package ucloud
 
 import (
 	"fmt"
+	"log"
 	"testing"
 	"time"
 
@@ -12,7 +13,43 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/uhost"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_disk_attachment", &resource.Sweeper{
+		Name: "ucloud_disk_attachment",
+		F:    testSweepDiskAttachments,
+	})
+}
+
+func testSweepDiskAttachments(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	disks, err := testSweepListDisks(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range disks {
+		if item.UHostId == "" {
+			continue
+		}
+
+		id := fmt.Sprintf("disk#%s:uhost#%s", item.UDiskId, item.UHostId)
+		log.Printf("[INFO] Destroying disk attachment %s", id)
+		attributes := map[string]interface{}{"availability_zone": item.Zone}
+		if err := testSweepDeleteResource(client, resourceUCloudDiskAttachment(), id, attributes); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudDiskAttachment_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var diskSet udisk.UDiskDataSet
 	var instance uhost.UHostInstanceSet
 
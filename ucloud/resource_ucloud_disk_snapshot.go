@@ -0,0 +1,224 @@
+package ucloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func resourceUCloudDiskSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUCloudDiskSnapshotCreate,
+		Read:   resourceUCloudDiskSnapshotRead,
+		Delete: resourceUCloudDiskSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disk_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      resource.PrefixedUniqueId("tf-disk-snapshot-"),
+				ValidateFunc: validateDiskName,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"charge_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "dynamic",
+				ValidateFunc: validation.StringInSlice([]string{"year", "month", "dynamic"}, false),
+			},
+
+			"duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateDuration,
+			},
+
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"disk_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expire_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUCloudDiskSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.udiskconn
+
+	diskId := d.Get("disk_id").(string)
+	diskSet, err := client.describeDiskById(diskId)
+	if err != nil {
+		return fmt.Errorf("error on reading disk %s when creating disk snapshot, %s", diskId, err)
+	}
+
+	req := conn.NewCreateUDiskSnapshotRequest()
+	req.Zone = ucloud.String(diskSet.Zone)
+	req.UDiskId = ucloud.String(diskId)
+	req.Name = ucloud.String(d.Get("name").(string))
+	req.ChargeType = ucloud.String(upperCamelCvt.unconvert(d.Get("charge_type").(string)))
+	req.Quantity = ucloud.Int(d.Get("duration").(int))
+
+	if v, ok := d.GetOk("description"); ok {
+		req.Comment = ucloud.String(v.(string))
+	}
+
+	resp, err := conn.CreateUDiskSnapshot(req)
+	if err != nil {
+		return fmt.Errorf("error on creating disk snapshot, %s", err)
+	}
+
+	if len(resp.SnapshotId) != 1 {
+		return fmt.Errorf("error on creating disk snapshot, expected exactly one snapshot, got %v", len(resp.SnapshotId))
+	}
+
+	d.SetId(resp.SnapshotId[0])
+	d.Set("availability_zone", diskSet.Zone)
+
+	// after create disk snapshot, we need to wait it normal
+	stateConf := diskSnapshotWaitForState(client, diskSet.Zone, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for disk snapshot %s complete creating, %s", d.Id(), err)
+	}
+
+	return resourceUCloudDiskSnapshotRead(d, meta)
+}
+
+func resourceUCloudDiskSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	snapshotSet, err := client.describeDiskSnapshotById(d.Get("availability_zone").(string), d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading disk snapshot %s, %s", d.Id(), err)
+	}
+
+	// the zone of snapshot is unknown after imported, read it from the source disk
+	if d.Get("availability_zone").(string) == "" {
+		diskSet, err := client.describeDiskById(snapshotSet.UDiskId)
+		if err != nil {
+			return fmt.Errorf("error on reading disk %s when reading disk snapshot %s, %s", snapshotSet.UDiskId, d.Id(), err)
+		}
+		d.Set("availability_zone", diskSet.Zone)
+	}
+
+	d.Set("disk_id", snapshotSet.UDiskId)
+	d.Set("name", snapshotSet.Name)
+	d.Set("description", snapshotSet.Comment)
+	d.Set("charge_type", upperCamelCvt.convert(snapshotSet.ChargeType))
+	d.Set("disk_size", snapshotSet.Size)
+	d.Set("status", snapshotSet.Status)
+	d.Set("create_time", timestampToString(snapshotSet.CreateTime))
+	d.Set("expire_time", timestampToString(snapshotSet.ExpiredTime))
+
+	return nil
+}
+
+func resourceUCloudDiskSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.udiskextconn
+
+	zone := d.Get("availability_zone").(string)
+	req := conn.NewDeleteUDiskSnapshotRequest()
+	req.Zone = ucloud.String(zone)
+	req.SnapshotId = ucloud.String(d.Id())
+
+	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		if _, err := conn.DeleteUDiskSnapshot(req); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error on deleting disk snapshot %s, %s", d.Id(), err))
+		}
+
+		_, err := client.describeDiskSnapshotById(zone, d.Id())
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("error on reading disk snapshot when deleting %s, %s", d.Id(), err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("the specified disk snapshot %s has not been deleted due to unknown error", d.Id()))
+	})
+}
+
+func diskSnapshotWaitForState(client *UCloudClient, zone, snapshotId string, timeout time.Duration) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending:    []string{statusPending},
+		Target:     []string{"normal"},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			snapshotSet, err := client.describeDiskSnapshotById(zone, snapshotId)
+			if err != nil {
+				if isNotFoundError(err) {
+					return nil, statusPending, nil
+				}
+				return nil, "", err
+			}
+
+			state := strings.ToLower(snapshotSet.Status)
+			if state == "failed" {
+				return nil, "", fmt.Errorf("the specified disk snapshot %s is failed", snapshotId)
+			}
+
+			if state != "normal" {
+				state = statusPending
+			}
+
+			return snapshotSet, state, nil
+		},
+	}
+}
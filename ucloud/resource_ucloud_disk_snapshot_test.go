@@ -0,0 +1,165 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func init() {
+	resource.AddTestSweepers("ucloud_disk_snapshot", &resource.Sweeper{
+		Name: "ucloud_disk_snapshot",
+		F:    testSweepDiskSnapshots,
+	})
+}
+
+func testSweepDiskSnapshots(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	zones, err := testSweepListZones(client)
+	if err != nil {
+		return err
+	}
+
+	conn := client.udiskextconn
+	var errs testSweepErrors
+	for _, zone := range zones {
+		req := conn.NewDescribeUDiskSnapshotRequest()
+		req.Zone = ucloud.String(zone)
+
+		var snapshots []uDiskSnapshotSet
+		limit := 100
+		for offset := 0; ; offset += limit {
+			req.Limit = ucloud.Int(limit)
+			req.Offset = ucloud.Int(offset)
+			resp, err := conn.DescribeUDiskSnapshot(req)
+			if err != nil {
+				return fmt.Errorf("error on reading disk snapshot list, %s", err)
+			}
+
+			for _, item := range resp.DataSet {
+				if isSweepableName(item.Name) {
+					snapshots = append(snapshots, item)
+				}
+			}
+
+			if len(resp.DataSet) < limit {
+				break
+			}
+		}
+
+		for _, item := range snapshots {
+			log.Printf("[INFO] Destroying disk snapshot %s (%s)", item.SnapshotId, item.Name)
+			attributes := map[string]interface{}{"availability_zone": zone}
+			if err := testSweepDeleteResource(client, resourceUCloudDiskSnapshot(), item.SnapshotId, attributes); err != nil {
+				errs.add(err)
+			}
+		}
+	}
+	return errs.err()
+}
+
+func TestAccUCloudDiskSnapshot_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	var snapshotSet uDiskSnapshotSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_disk_snapshot.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckDiskSnapshotDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDiskSnapshotConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskSnapshotExists("ucloud_disk_snapshot.foo", &snapshotSet),
+					resource.TestCheckResourceAttr("ucloud_disk_snapshot.foo", "name", "tf-acc-disk-snapshot"),
+					resource.TestCheckResourceAttr("ucloud_disk_snapshot.foo", "description", "tf-acc-disk-snapshot"),
+					resource.TestCheckResourceAttr("ucloud_disk_snapshot.foo", "disk_size", "10"),
+					resource.TestCheckResourceAttr("ucloud_disk_snapshot.foo", "status", "Normal"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDiskSnapshotExists(n string, snapshotSet *uDiskSnapshotSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("disk snapshot id is empty")
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		ptr, err := client.describeDiskSnapshotById(rs.Primary.Attributes["availability_zone"], rs.Primary.ID)
+
+		log.Printf("[INFO] disk snapshot id %#v", rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*snapshotSet = *ptr
+		return nil
+	}
+}
+
+func testAccCheckDiskSnapshotDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_disk_snapshot" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		snapshotSet, err := client.describeDiskSnapshotById(rs.Primary.Attributes["availability_zone"], rs.Primary.ID)
+
+		// Verify the error is what we want
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if snapshotSet.SnapshotId != "" {
+			return fmt.Errorf("disk snapshot still exist")
+		}
+	}
+
+	return nil
+}
+
+const testAccDiskSnapshotConfig = `
+data "ucloud_zones" "default" {}
+
+resource "ucloud_disk" "foo" {
+	availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+	name              = "tf-acc-disk-snapshot"
+	tag               = "tf-acc"
+	disk_size         = 10
+}
+
+resource "ucloud_disk_snapshot" "foo" {
+	disk_id     = "${ucloud_disk.foo.id}"
+	name        = "tf-acc-disk-snapshot"
+	description = "tf-acc-disk-snapshot"
+}
+`
@@ -10,7 +10,40 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/udisk"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_disk", &resource.Sweeper{
+		Name: "ucloud_disk",
+		Dependencies: []string{
+			"ucloud_disk_attachment",
+		},
+		F: testSweepDisks,
+	})
+}
+
+func testSweepDisks(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	disks, err := testSweepListDisks(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range disks {
+		log.Printf("[INFO] Destroying disk %s (%s)", item.UDiskId, item.Name)
+		if err := testSweepDeleteResource(client, resourceUCloudDisk(), item.UDiskId, nil); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudDisk_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var diskSet udisk.UDiskDataSet
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -49,6 +82,8 @@ func TestAccUCloudDisk_basic(t *testing.T) {
 }
 
 func TestAccUCloudDisk_tag(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var diskSet udisk.UDiskDataSet
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -76,6 +111,103 @@ func TestAccUCloudDisk_tag(t *testing.T) {
 	})
 }
 
+func TestAccUCloudDisk_ssd(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	var diskSet udisk.UDiskDataSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_disk.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckDiskDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDiskConfigSSD,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskExists("ucloud_disk.foo", &diskSet),
+					testAccCheckDiskType(&diskSet, "SSDDataDisk"),
+					resource.TestCheckResourceAttr("ucloud_disk.foo", "disk_type", "ssd_data_disk"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUCloudDisk_dataArk(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	var diskSet udisk.UDiskDataSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_disk.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckDiskDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDiskConfigDataArk("false"),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskExists("ucloud_disk.foo", &diskSet),
+					resource.TestCheckResourceAttr("ucloud_disk.foo", "name", "tf-acc-disk-data-ark"),
+					resource.TestCheckResourceAttr("ucloud_disk.foo", "data_ark", "false"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccDiskConfigDataArk("true"),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskExists("ucloud_disk.foo", &diskSet),
+					resource.TestCheckResourceAttr("ucloud_disk.foo", "name", "tf-acc-disk-data-ark"),
+					resource.TestCheckResourceAttr("ucloud_disk.foo", "data_ark", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUCloudDisk_clone(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	var diskSet udisk.UDiskDataSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_disk.bar",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckDiskDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDiskConfigClone,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDiskExists("ucloud_disk.bar", &diskSet),
+					testAccCheckDiskAttributes(&diskSet),
+					resource.TestCheckResourceAttr("ucloud_disk.bar", "name", "tf-acc-disk-clone-from-disk"),
+					resource.TestCheckResourceAttr("ucloud_disk.bar", "disk_size", "20"),
+					resource.TestCheckResourceAttr("ucloud_disk.baz", "name", "tf-acc-disk-clone-from-snapshot"),
+					resource.TestCheckResourceAttr("ucloud_disk.baz", "disk_size", "10"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDiskExists(n string, diskSet *udisk.UDiskDataSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -111,6 +243,15 @@ func testAccCheckDiskAttributes(diskSet *udisk.UDiskDataSet) resource.TestCheckF
 	}
 }
 
+func testAccCheckDiskType(diskSet *udisk.UDiskDataSet, diskType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if diskSet.DiskType != diskType {
+			return fmt.Errorf("expected disk type %s, got %s", diskType, diskSet.DiskType)
+		}
+		return nil
+	}
+}
+
 func testAccCheckDiskDestroy(s *terraform.State) error {
 
 	for _, rs := range s.RootModule().Resources {
@@ -174,3 +315,59 @@ resource "ucloud_disk" "foo" {
 	disk_size         = 10
 }
 `
+
+const testAccDiskConfigSSD = `
+data "ucloud_zones" "default" {}
+
+resource "ucloud_disk" "foo" {
+	availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+	name              = "tf-acc-disk-ssd"
+	tag               = "tf-acc"
+	disk_size         = 10
+	disk_type         = "ssd_data_disk"
+}
+`
+
+const testAccDiskConfigClone = `
+data "ucloud_zones" "default" {}
+
+resource "ucloud_disk" "foo" {
+	availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+	name              = "tf-acc-disk-clone"
+	tag               = "tf-acc"
+	disk_size         = 10
+}
+
+resource "ucloud_disk" "bar" {
+	availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+	name              = "tf-acc-disk-clone-from-disk"
+	disk_size         = 20
+	source_disk_id    = "${ucloud_disk.foo.id}"
+}
+
+resource "ucloud_disk_snapshot" "foo" {
+	disk_id = "${ucloud_disk.foo.id}"
+	name    = "tf-acc-disk-clone"
+}
+
+resource "ucloud_disk" "baz" {
+	availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+	name              = "tf-acc-disk-clone-from-snapshot"
+	disk_size         = 10
+	snapshot_id       = "${ucloud_disk_snapshot.foo.id}"
+}
+`
+
+func testAccDiskConfigDataArk(dataArk string) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+resource "ucloud_disk" "foo" {
+	availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+	name              = "tf-acc-disk-data-ark"
+	tag               = "tf-acc"
+	disk_size         = 10
+	data_ark          = %s
+}
+`, dataArk)
+}
@@ -23,10 +23,11 @@ func resourceUCloudEIP() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"bandwidth": &schema.Schema{
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      1,
-				ValidateFunc: validation.IntBetween(1, 800),
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateFunc:     validation.IntBetween(1, 800),
+				DiffSuppressFunc: eipShareBandwidthDiffSuppressFunc,
 			},
 
 			"internet_type": &schema.Schema{
@@ -59,6 +60,7 @@ func resourceUCloudEIP() *schema.Resource {
 					"traffic",
 					"bandwidth",
 				}, false),
+				DiffSuppressFunc: eipShareBandwidthDiffSuppressFunc,
 			},
 
 			"duration": &schema.Schema{
@@ -90,6 +92,13 @@ func resourceUCloudEIP() *schema.Resource {
 				StateFunc:    stateFuncTag,
 			},
 
+			"share_bandwidth_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"status": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -171,6 +180,13 @@ func resourceUCloudEIPCreate(d *schema.ResourceData, meta interface{}) error {
 		req.Remark = ucloud.String(v.(string))
 	}
 
+	// the bandwidth of eip is managed by share bandwidth when it is specified
+	if v, ok := d.GetOk("share_bandwidth_id"); ok {
+		req.ShareBandwidthId = ucloud.String(v.(string))
+		req.PayMode = ucloud.String("ShareBandwidth")
+		req.Bandwidth = ucloud.Int(0)
+	}
+
 	resp, err := conn.AllocateEIP(req)
 	if err != nil {
 		return fmt.Errorf("error on creating eip, %s", err)
@@ -304,9 +320,14 @@ func resourceUCloudEIPRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error on reading eip %s, %s", d.Id(), err)
 	}
 
-	d.Set("bandwidth", eip.Bandwidth)
+	// the bandwidth and charge mode is owned by share bandwidth when eip is a member of it
+	if eip.PayMode != "ShareBandwidth" {
+		d.Set("bandwidth", eip.Bandwidth)
+		d.Set("charge_mode", upperCamelCvt.convert(eip.PayMode))
+	}
+
+	d.Set("share_bandwidth_id", eip.ShareBandwidthSet.ShareBandwidthId)
 	d.Set("charge_type", upperCamelCvt.convert(eip.ChargeType))
-	d.Set("charge_mode", upperCamelCvt.convert(eip.PayMode))
 	d.Set("name", eip.Name)
 	d.Set("remark", eip.Remark)
 	d.Set("tag", eip.Tag)
@@ -385,3 +406,9 @@ func eipWaitForState(client *UCloudClient, eipId string) *resource.StateChangeCo
 		},
 	}
 }
+
+// eipShareBandwidthDiffSuppressFunc will ignore the changes of bandwidth and charge mode,
+// which are managed by share bandwidth when the eip is a member of it.
+func eipShareBandwidthDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && d.Get("share_bandwidth_id").(string) != ""
+}
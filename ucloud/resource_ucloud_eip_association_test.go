@@ -2,6 +2,7 @@ package ucloud
 
 import (
 	"fmt"
+	"log"
 	"testing"
 	"time"
 
@@ -12,7 +13,42 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/unet"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_eip_association", &resource.Sweeper{
+		Name: "ucloud_eip_association",
+		F:    testSweepEIPAssociations,
+	})
+}
+
+func testSweepEIPAssociations(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	eips, err := testSweepListEIPs(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range eips {
+		if item.Resource.ResourceId == "" {
+			continue
+		}
+
+		id := fmt.Sprintf("eip#%s:%s#%s", item.EIPId, item.Resource.ResourceType, item.Resource.ResourceId)
+		log.Printf("[INFO] Destroying eip association %s", id)
+		if err := testSweepDeleteResource(client, resourceUCloudEIPAssociation(), id, nil); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudEIPAssociation_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var eip unet.UnetEIPSet
 	var instance uhost.UHostInstanceSet
 
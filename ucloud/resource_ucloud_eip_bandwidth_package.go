@@ -0,0 +1,225 @@
+package ucloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func resourceUCloudEIPBandwidthPackage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUCloudEIPBandwidthPackageCreate,
+		Read:   resourceUCloudEIPBandwidthPackageRead,
+		Delete: resourceUCloudEIPBandwidthPackageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceUCloudEIPBandwidthPackageCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"eip_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"bandwidth": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(2, 800),
+			},
+
+			"duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"enable_time": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// the same time may be present with different time zone
+					oldTs, err := stringToTimestamp(old)
+					if err != nil {
+						return false
+					}
+
+					newTs, err := stringToTimestamp(new)
+					if err != nil {
+						return false
+					}
+
+					return oldTs == newTs
+				},
+			},
+
+			"disable_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUCloudEIPBandwidthPackageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	req := conn.NewCreateBandwidthPackageRequest()
+	req.EIPId = ucloud.String(d.Get("eip_id").(string))
+	req.Bandwidth = ucloud.Int(d.Get("bandwidth").(int))
+	req.TimeRange = ucloud.Int(d.Get("duration").(int))
+
+	// if enable time is not specified, the bandwidth package is enabled immediately
+	if v, ok := d.GetOk("enable_time"); ok {
+		enableTime, err := stringToTimestamp(v.(string))
+		if err != nil {
+			return fmt.Errorf("error on parsing enable time %q, %s", v.(string), err)
+		}
+
+		if int64(enableTime) < time.Now().Unix() {
+			return fmt.Errorf("error on creating eip bandwidth package, enable time %q should not be earlier than now", v.(string))
+		}
+
+		req.EnableTime = ucloud.Int(enableTime)
+	}
+
+	resp, err := conn.CreateBandwidthPackage(req)
+	if err != nil {
+		return fmt.Errorf("error on creating eip bandwidth package, %s", err)
+	}
+
+	d.SetId(resp.BandwidthPackageId)
+
+	// after create bandwidth package, we need to wait it initialized
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{statusPending},
+		Target:     []string{statusInitialized},
+		Timeout:    5 * time.Minute,
+		Delay:      2 * time.Second,
+		MinTimeout: 1 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			packageSet, err := client.describeBandwidthPackageById(d.Id())
+			if err != nil {
+				if isNotFoundError(err) {
+					return nil, statusPending, nil
+				}
+				return nil, "", err
+			}
+
+			return packageSet, statusInitialized, nil
+		},
+	}
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for eip bandwidth package %s complete creating, %s", d.Id(), err)
+	}
+
+	return resourceUCloudEIPBandwidthPackageRead(d, meta)
+}
+
+func resourceUCloudEIPBandwidthPackageCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// the enable time is only used to create bandwidth package, the expired one is removed from state by Read
+	// and would be created again, so it is rejected at plan time instead of failing at apply time
+	if d.Id() != "" {
+		return nil
+	}
+
+	v, ok := d.GetOk("enable_time")
+	if !ok {
+		return nil
+	}
+
+	enableTime, err := stringToTimestamp(v.(string))
+	if err != nil {
+		return nil
+	}
+
+	now := time.Now().Unix()
+	if int64(enableTime+d.Get("duration").(int)*3600) <= now {
+		return fmt.Errorf("the eip bandwidth package enabled at %q for %d hours has been expired, please remove it from the configuration", v.(string), d.Get("duration").(int))
+	}
+
+	if int64(enableTime) < now {
+		return fmt.Errorf("enable_time %q should not be earlier than now", v.(string))
+	}
+
+	return nil
+}
+
+func resourceUCloudEIPBandwidthPackageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	packageSet, err := client.describeBandwidthPackageById(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading eip bandwidth package %s, %s", d.Id(), err)
+	}
+
+	// the bandwidth package which has been expired is regarded as deleted
+	if int64(packageSet.DisableTime) <= time.Now().Unix() {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("eip_id", packageSet.EIPId)
+	d.Set("bandwidth", packageSet.Bandwidth)
+	d.Set("duration", (packageSet.DisableTime-packageSet.EnableTime)/3600)
+	d.Set("enable_time", timestampToString(packageSet.EnableTime))
+	d.Set("disable_time", timestampToString(packageSet.DisableTime))
+	d.Set("create_time", timestampToString(packageSet.CreateTime))
+
+	return nil
+}
+
+func resourceUCloudEIPBandwidthPackageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	req := conn.NewDeleteBandwidthPackageRequest()
+	req.BandwidthPackageId = ucloud.String(d.Id())
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		// the bandwidth package may be expired during deleting
+		if _, err := client.describeBandwidthPackageById(d.Id()); err != nil {
+			if isNotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("error on reading eip bandwidth package when deleting %s, %s", d.Id(), err))
+		}
+
+		if _, err := conn.DeleteBandwidthPackage(req); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error on deleting eip bandwidth package %s, %s", d.Id(), err))
+		}
+
+		_, err := client.describeBandwidthPackageById(d.Id())
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("error on reading eip bandwidth package when deleting %s, %s", d.Id(), err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("the specified eip bandwidth package %s has not been deleted due to unknown error", d.Id()))
+	})
+}
@@ -0,0 +1,191 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func init() {
+	resource.AddTestSweepers("ucloud_eip_bandwidth_package", &resource.Sweeper{
+		Name: "ucloud_eip_bandwidth_package",
+		F:    testSweepEIPBandwidthPackages,
+	})
+}
+
+func testSweepEIPBandwidthPackages(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	// the bandwidth package has no name, it is swept if the eip is created by acceptance tests
+	eips, err := testSweepListEIPs(client)
+	if err != nil {
+		return err
+	}
+
+	eipIds := map[string]bool{}
+	for _, item := range eips {
+		eipIds[item.EIPId] = true
+	}
+
+	conn := client.unetconn
+	req := conn.NewDescribeBandwidthPackageRequest()
+
+	var packages []unet.UnetBandwidthPackageSet
+	limit := 100
+	for offset := 0; ; offset += limit {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeBandwidthPackage(req)
+		if err != nil {
+			return fmt.Errorf("error on reading eip bandwidth package list, %s", err)
+		}
+
+		for _, item := range resp.DataSets {
+			if eipIds[item.EIPId] {
+				packages = append(packages, item)
+			}
+		}
+
+		if len(resp.DataSets) < limit {
+			break
+		}
+	}
+
+	var errs testSweepErrors
+	for _, item := range packages {
+		log.Printf("[INFO] Destroying eip bandwidth package %s of eip %s", item.BandwidthPackageId, item.EIPId)
+		if err := testSweepDeleteResource(client, resourceUCloudEIPBandwidthPackage(), item.BandwidthPackageId, nil); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
+func TestAccUCloudEIPBandwidthPackage_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	var packageSet unet.UnetBandwidthPackageSet
+	var eip unet.UnetEIPSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_eip_bandwidth_package.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckEIPBandwidthPackageDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccEIPBandwidthPackageConfigExpired,
+				ExpectError: regexp.MustCompile("has been expired, please remove it from the configuration"),
+			},
+
+			resource.TestStep{
+				Config: testAccEIPBandwidthPackageConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPExists("ucloud_eip.foo", &eip),
+					testAccCheckEIPBandwidthPackageExists("ucloud_eip_bandwidth_package.foo", &packageSet),
+					resource.TestCheckResourceAttr("ucloud_eip_bandwidth_package.foo", "bandwidth", "5"),
+					resource.TestCheckResourceAttr("ucloud_eip_bandwidth_package.foo", "duration", "2"),
+					resource.TestCheckResourceAttrSet("ucloud_eip_bandwidth_package.foo", "enable_time"),
+					resource.TestCheckResourceAttrSet("ucloud_eip_bandwidth_package.foo", "disable_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEIPBandwidthPackageExists(n string, packageSet *unet.UnetBandwidthPackageSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("eip bandwidth package id is empty")
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		ptr, err := client.describeBandwidthPackageById(rs.Primary.ID)
+
+		log.Printf("[INFO] eip bandwidth package id %#v", rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*packageSet = *ptr
+		return nil
+	}
+}
+
+func testAccCheckEIPBandwidthPackageDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_eip_bandwidth_package" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		d, err := client.describeBandwidthPackageById(rs.Primary.ID)
+
+		// Verify the error is what we want
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if d.BandwidthPackageId != "" {
+			return fmt.Errorf("eip bandwidth package still exist")
+		}
+	}
+
+	return nil
+}
+
+const testAccEIPBandwidthPackageConfig = `
+resource "ucloud_eip" "foo" {
+	name          = "tf-acc-eip-bandwidth-package"
+	bandwidth     = 1
+	internet_type = "bgp"
+	charge_mode   = "bandwidth"
+	tag           = "tf-acc"
+}
+
+resource "ucloud_eip_bandwidth_package" "foo" {
+	eip_id    = "${ucloud_eip.foo.id}"
+	bandwidth = 5
+	duration  = 2
+}
+`
+
+const testAccEIPBandwidthPackageConfigExpired = `
+resource "ucloud_eip" "foo" {
+	name          = "tf-acc-eip-bandwidth-package"
+	bandwidth     = 1
+	internet_type = "bgp"
+	charge_mode   = "bandwidth"
+	tag           = "tf-acc"
+}
+
+resource "ucloud_eip_bandwidth_package" "foo" {
+	eip_id      = "${ucloud_eip.foo.id}"
+	bandwidth   = 5
+	duration    = 2
+	enable_time = "2019-01-01T10:00:00+08:00"
+}
+`
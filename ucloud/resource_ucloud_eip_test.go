@@ -10,7 +10,42 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/unet"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_eip", &resource.Sweeper{
+		Name: "ucloud_eip",
+		Dependencies: []string{
+			"ucloud_eip_association",
+			"ucloud_eip_bandwidth_package",
+			"ucloud_share_bandwidth_association",
+		},
+		F: testSweepEIPs,
+	})
+}
+
+func testSweepEIPs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	eips, err := testSweepListEIPs(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range eips {
+		log.Printf("[INFO] Destroying eip %s (%s)", item.EIPId, item.Name)
+		if err := testSweepDeleteResource(client, resourceUCloudEIP(), item.EIPId, nil); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudEIP_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var eip unet.UnetEIPSet
 
 	resource.ParallelTest(t, resource.TestCase{
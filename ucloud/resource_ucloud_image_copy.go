@@ -0,0 +1,179 @@
+package ucloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func resourceUCloudImageCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUCloudImageCopyCreate,
+		Read:   resourceUCloudImageCopyRead,
+		Delete: resourceUCloudImageCopyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target_region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"target_project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      resource.PrefixedUniqueId("tf-image-copy-"),
+				ValidateFunc: validateName,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"os_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUCloudImageCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.uhostconn
+
+	// the image will be copied into the region and project of provider by default
+	targetRegion := client.region
+	if v, ok := d.GetOk("target_region"); ok {
+		targetRegion = v.(string)
+	}
+
+	targetProjectId := client.projectId
+	if v, ok := d.GetOk("target_project_id"); ok {
+		targetProjectId = v.(string)
+	}
+
+	req := conn.NewCopyCustomImageRequest()
+	req.SourceImageId = ucloud.String(d.Get("source_image_id").(string))
+	req.TargetProjectId = ucloud.String(targetProjectId)
+	req.TargetImageName = ucloud.String(d.Get("name").(string))
+
+	if targetRegion != client.region {
+		req.TargetRegion = ucloud.String(targetRegion)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		req.TargetImageDescription = ucloud.String(v.(string))
+	}
+
+	resp, err := conn.CopyCustomImage(req)
+	if err != nil {
+		return fmt.Errorf("error on creating image copy, %s", err)
+	}
+
+	d.SetId(resp.TargetImageId)
+	d.Set("target_region", targetRegion)
+	d.Set("target_project_id", targetProjectId)
+
+	// after copy image, we need to wait it available in the target region
+	stateConf := imageWaitForState(client, d.Id(), targetRegion, targetProjectId, d.Timeout(schema.TimeoutCreate))
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for image copy %s complete creating, %s", d.Id(), err)
+	}
+
+	return resourceUCloudImageCopyRead(d, meta)
+}
+
+func resourceUCloudImageCopyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	targetRegion := d.Get("target_region").(string)
+	targetProjectId := d.Get("target_project_id").(string)
+
+	imageSet, err := client.describeImageByRegion(d.Id(), targetRegion, targetProjectId)
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading image copy %s, %s", d.Id(), err)
+	}
+
+	d.Set("name", imageSet.ImageName)
+	d.Set("description", imageSet.ImageDescription)
+	d.Set("availability_zone", imageSet.Zone)
+	d.Set("type", upperCamelCvt.convert(imageSet.ImageType))
+	d.Set("size", imageSet.ImageSize)
+	d.Set("os_type", upperCamelCvt.convert(imageSet.OsType))
+	d.Set("os_name", imageSet.OsName)
+	d.Set("status", imageSet.State)
+	d.Set("create_time", timestampToString(imageSet.CreateTime))
+
+	return nil
+}
+
+func resourceUCloudImageCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	targetRegion := d.Get("target_region").(string)
+	targetProjectId := d.Get("target_project_id").(string)
+
+	return deleteCustomImage(client, d.Id(), targetRegion, targetProjectId, d.Timeout(schema.TimeoutDelete))
+}
@@ -0,0 +1,172 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/ucloud/ucloud-sdk-go/services/uhost"
+)
+
+func init() {
+	resource.AddTestSweepers("ucloud_image_copy", &resource.Sweeper{
+		Name: "ucloud_image_copy",
+		F:    testSweepImageCopies,
+	})
+}
+
+// testSweepImageCopies will destroy the custom images in the region of sweeper,
+// the copied image can not be distinguished from the source image by remote api,
+// so the sweeper of custom image is only used to destroy the remaining images after that.
+func testSweepImageCopies(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	images, err := testSweepListCustomImages(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range images {
+		log.Printf("[INFO] Destroying image copy %s (%s)", item.ImageId, item.ImageName)
+		attributes := map[string]interface{}{
+			"target_region":     client.region,
+			"target_project_id": client.projectId,
+		}
+		if err := testSweepDeleteResource(client, resourceUCloudImageCopy(), item.ImageId, attributes); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
+func TestAccUCloudImageCopy_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+	var imageSet uhost.UHostImageSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_image_copy.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckImageCopyDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImageCopyConfig(rInt),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageCopyExists("ucloud_image_copy.foo", &imageSet),
+					resource.TestCheckResourceAttr("ucloud_image_copy.foo", "name", "tf-acc-image-copy"),
+					resource.TestCheckResourceAttr("ucloud_image_copy.foo", "target_region", "cn-sh2"),
+					resource.TestCheckResourceAttr("ucloud_image_copy.foo", "status", "Available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageCopyExists(n string, imageSet *uhost.UHostImageSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("image copy id is empty")
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		ptr, err := client.describeImageByRegion(rs.Primary.ID, rs.Primary.Attributes["target_region"], rs.Primary.Attributes["target_project_id"])
+
+		log.Printf("[INFO] image copy id %#v", rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*imageSet = *ptr
+		return nil
+	}
+}
+
+func testAccCheckImageCopyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_image_copy" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		imageSet, err := client.describeImageByRegion(rs.Primary.ID, rs.Primary.Attributes["target_region"], rs.Primary.Attributes["target_project_id"])
+
+		// Verify the error is what we want
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if imageSet.ImageId != "" {
+			return fmt.Errorf("image copy still exist")
+		}
+	}
+
+	return testAccCheckCustomImageDestroy(s)
+}
+
+func testAccImageCopyConfig(rInt int) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_security_group" "default" {
+  name = "tf-acc-image-copy-%d"
+  tag  = "tf-acc"
+
+  rules {
+    port_range = "80"
+    protocol   = "tcp"
+    cidr_block = "192.168.0.0/16"
+    policy     = "accept"
+  }
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  security_group    = "${ucloud_security_group.default.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-image-copy"
+  tag               = "tf-acc"
+}
+
+resource "ucloud_custom_image" "foo" {
+  instance_id = "${ucloud_instance.foo.id}"
+  name        = "tf-acc-image-copy-source"
+}
+
+resource "ucloud_image_copy" "foo" {
+  source_image_id = "${ucloud_custom_image.foo.id}"
+  target_region   = "cn-sh2"
+  name            = "tf-acc-image-copy"
+}`, rInt)
+}
@@ -1,6 +1,7 @@
 package ucloud
 
 import (
+	"encoding/base64"
 	"fmt"
 	"strings"
 	"time"
@@ -22,6 +23,8 @@ func resourceUCloudInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceUCloudInstanceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -41,10 +44,51 @@ func resourceUCloudInstance() *schema.Resource {
 			},
 
 			"root_password": &schema.Schema{
-				Type:         schema.TypeString,
-				Required:     true,
-				Sensitive:    true,
-				ValidateFunc: validateInstancePassword,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validateInstancePassword,
+				ConflictsWith: []string{"key_pair"},
+			},
+
+			"key_pair": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateInstanceKeyPair,
+				ConflictsWith: []string{"root_password"},
+			},
+
+			"user_data": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateInstanceUserData,
+				ConflictsWith: []string{"user_data_base64"},
+			},
+
+			"user_data_base64": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateInstanceUserDataBase64,
+				ConflictsWith: []string{"user_data"},
+			},
+
+			"reserve_data_disk": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"dns_servers": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.SingleIP(),
+				},
 			},
 
 			"instance_type": &schema.Schema{
@@ -109,6 +153,13 @@ func resourceUCloudInstance() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"local_normal", "local_ssd"}, false),
 			},
 
+			"backup_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none",
+				ValidateFunc: validation.StringInSlice([]string{"none", "data_ark"}, false),
+			},
+
 			"remark": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -143,6 +194,21 @@ func resourceUCloudInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"private_ip": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+
+			"private_mac": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateInstancePrivateMac,
+			},
+
 			"cpu": &schema.Schema{
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -230,14 +296,21 @@ func resourceUCloudInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	bootDiskType := d.Get("boot_disk_type").(string)
 
 	req := conn.NewCreateUHostInstanceRequest()
-	req.LoginMode = ucloud.String("Password")
 	req.Zone = ucloud.String(d.Get("availability_zone").(string))
 	req.ImageId = ucloud.String(imageId)
-	req.Password = ucloud.String(d.Get("root_password").(string))
 	req.ChargeType = ucloud.String(upperCamelCvt.unconvert(d.Get("charge_type").(string)))
 	req.Quantity = ucloud.Int(d.Get("duration").(int))
 	req.Name = ucloud.String(d.Get("name").(string))
 
+	// exactly one of root_password and key_pair has been checked by CustomizeDiff
+	if keyPair, ok := d.GetOk("key_pair"); ok {
+		req.LoginMode = ucloud.String("KeyPair")
+		req.KeyPair = ucloud.String(keyPair.(string))
+	} else {
+		req.LoginMode = ucloud.String("Password")
+		req.Password = ucloud.String(d.Get("root_password").(string))
+	}
+
 	// skip error because it has been validated by schema
 	t, _ := parseInstanceType(d.Get("instance_type").(string))
 	req.CPU = ucloud.Int(t.CPU)
@@ -273,6 +346,8 @@ func resourceUCloudInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		req.Disks = append(req.Disks, dataDisk)
 	}
 
+	req.TimemachineFeature = ucloud.String(boolCamelCvt.convert(d.Get("backup_mode").(string) == "data_ark"))
+
 	// if tag is empty string, use default tag
 	if v, ok := d.GetOk("tag"); ok {
 		req.Tag = ucloud.String(v.(string))
@@ -280,6 +355,15 @@ func resourceUCloudInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		req.Tag = ucloud.String(defaultTag)
 	}
 
+	// the user data script of cloud-init must be encoded by base64
+	if v, ok := d.GetOk("user_data"); ok {
+		req.UserDataScript = ucloud.String(base64.StdEncoding.EncodeToString([]byte(v.(string))))
+	}
+
+	if v, ok := d.GetOk("user_data_base64"); ok {
+		req.UserDataScript = ucloud.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("vpc_id"); ok {
 		req.VPCId = ucloud.String(v.(string))
 	}
@@ -288,6 +372,34 @@ func resourceUCloudInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		req.SubnetId = ucloud.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("private_ip"); ok {
+		privateIp := v.(string)
+		subnetId, ok := d.GetOk("subnet_id")
+		if !ok {
+			return fmt.Errorf("error on creating instance, subnet_id must be set when private_ip is specified")
+		}
+
+		subnet, err := client.describeSubnetById(subnetId.(string))
+		if err != nil {
+			return fmt.Errorf("error on reading subnet %s when creating instance, %s", subnetId.(string), err)
+		}
+
+		cidr, err := parseCidrBlock(fmt.Sprintf("%s/%s", subnet.Subnet, subnet.Netmask))
+		if err != nil {
+			return fmt.Errorf("error on parsing cidr block of subnet %s when creating instance, %s", subnetId.(string), err)
+		}
+
+		if !cidr.Contains(privateIp) {
+			return fmt.Errorf("error on creating instance, private_ip %s is not included by the cidr block %s of subnet %s", privateIp, cidr, subnetId.(string))
+		}
+
+		req.PrivateIp = []string{privateIp}
+	}
+
+	if v, ok := d.GetOk("private_mac"); ok {
+		req.PrivateMac = ucloud.String(v.(string))
+	}
+
 	if val, ok := d.GetOk("security_group"); ok {
 		resp, err := client.describeFirewallById(val.(string))
 		if err != nil {
@@ -439,8 +551,22 @@ func resourceUCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		resizeNeedUpdate = true
 	}
 
+	reinstallNeedUpdate := false
+	if d.HasChange("image_id") && !d.IsNewResource() {
+		reinstallNeedUpdate = true
+	}
+
+	arkNeedUpdate := false
+	if d.HasChange("backup_mode") && !d.IsNewResource() {
+		if d.Get("backup_mode").(string) != "data_ark" {
+			return fmt.Errorf("error on updating instance %s, downgrading backup_mode from data_ark to none is not supported", d.Id())
+		}
+
+		arkNeedUpdate = true
+	}
+
 	passwordNeedUpdate := false
-	if d.HasChange("root_password") && !d.IsNewResource() {
+	if d.HasChange("root_password") && !d.IsNewResource() && !reinstallNeedUpdate {
 		instance, err := client.describeInstanceById(d.Id())
 
 		if err != nil {
@@ -458,7 +584,7 @@ func resourceUCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if passwordNeedUpdate || resizeNeedUpdate {
+	if reinstallNeedUpdate || arkNeedUpdate || passwordNeedUpdate || resizeNeedUpdate {
 		// instance update these attributes need to wait it stopped
 		stopReq := conn.NewStopUHostInstanceRequest()
 		stopReq.UHostId = ucloud.String(d.Id())
@@ -493,6 +619,74 @@ func resourceUCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 
+		if reinstallNeedUpdate {
+			reqReinstall := conn.NewReinstallUHostInstanceRequest()
+			reqReinstall.UHostId = ucloud.String(d.Id())
+			reqReinstall.ImageId = ucloud.String(d.Get("image_id").(string))
+			reqReinstall.ReserveDisk = ucloud.String(boolCamelCvt.convert(d.Get("reserve_data_disk").(bool)))
+
+			// the password of reinstall request must be encoded by base64,
+			// and it is not required when the instance is login by key pair
+			if v, ok := d.GetOk("root_password"); ok {
+				reqReinstall.Password = ucloud.String(base64.StdEncoding.EncodeToString([]byte(v.(string))))
+			}
+
+			if v, ok := d.GetOk("dns_servers"); ok {
+				reqReinstall.DNSServers = schemaListToStringSlice(v)
+			}
+
+			_, err := conn.ReinstallUHostInstance(reqReinstall)
+			if err != nil {
+				return fmt.Errorf("error on %s to instance %s, %s", "ReinstallUHostInstance", d.Id(), err)
+			}
+
+			// the instance is stopped before reinstalling, so it is completed only when the new image is in use
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{statusPending},
+				Target:     []string{statusStopped},
+				Refresh:    instanceReinstallRefreshFunc(client, d.Id(), d.Get("image_id").(string)),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Delay:      5 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			if _, err = stateConf.WaitForState(); err != nil {
+				return fmt.Errorf("error on waiting for %s complete to instance %s, %s", "ReinstallUHostInstance", d.Id(), err)
+			}
+
+			d.SetPartial("image_id")
+			d.SetPartial("root_password")
+			d.SetPartial("reserve_data_disk")
+			d.SetPartial("dns_servers")
+		}
+
+		if arkNeedUpdate {
+			reqArk := conn.NewUpgradeToArkUHostInstanceRequest()
+			reqArk.Zone = ucloud.String(d.Get("availability_zone").(string))
+			reqArk.UHostIds = []string{d.Id()}
+
+			_, err := conn.UpgradeToArkUHostInstance(reqArk)
+			if err != nil {
+				return fmt.Errorf("error on %s to instance %s, %s", "UpgradeToArkUHostInstance", d.Id(), err)
+			}
+
+			// instance stopped means instance upgrade complete
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{statusPending},
+				Target:     []string{statusStopped},
+				Refresh:    instanceStateRefreshFunc(client, d.Id(), statusStopped),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Delay:      5 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			if _, err = stateConf.WaitForState(); err != nil {
+				return fmt.Errorf("error on waiting for %s complete to instance %s, %s", "UpgradeToArkUHostInstance", d.Id(), err)
+			}
+
+			d.SetPartial("backup_mode")
+		}
+
 		if passwordNeedUpdate {
 			reqPassword := conn.NewResetUHostInstancePasswordRequest()
 			reqPassword.UHostId = ucloud.String(d.Id())
@@ -579,6 +773,12 @@ func resourceUCloudInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("instance_type", d.Get("instance_type").(string))
 	d.Set("root_password", d.Get("root_password").(string))
 	d.Set("security_group", d.Get("security_group").(string))
+
+	// the reserve_data_disk is missing after importing, it is true as default
+	if _, ok := d.GetOkExists("reserve_data_disk"); !ok {
+		d.Set("reserve_data_disk", true)
+	}
+
 	d.Set("tag", instance.Tag)
 	d.Set("cpu", instance.CPU)
 	d.Set("memory", instance.Memory)
@@ -588,6 +788,12 @@ func resourceUCloudInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("auto_renew", boolCamelCvt.unconvert(instance.AutoRenew))
 	d.Set("remark", instance.Remark)
 
+	if strings.ToLower(instance.TimemachineFeature) == "yes" {
+		d.Set("backup_mode", "data_ark")
+	} else {
+		d.Set("backup_mode", "none")
+	}
+
 	ipSet := []map[string]interface{}{}
 	for _, item := range instance.IPSet {
 		ipSet = append(ipSet, map[string]interface{}{
@@ -598,6 +804,7 @@ func resourceUCloudInstanceRead(d *schema.ResourceData, meta interface{}) error
 		if item.Type == "Private" {
 			d.Set("vpc_id", item.VPCId)
 			d.Set("subnet_id", item.SubnetId)
+			d.Set("private_ip", item.IP)
 		}
 	}
 
@@ -685,6 +892,55 @@ func resourceUCloudInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 	})
 }
 
+func resourceUCloudInstanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// exactly one of root_password and key_pair must be set as login credential,
+	// it is skipped when any of them is unknown until apply, such as it is interpolated by another resource
+	if diff.NewValueKnown("root_password") && diff.NewValueKnown("key_pair") {
+		_, hasPassword := diff.GetOk("root_password")
+		_, hasKeyPair := diff.GetOk("key_pair")
+		if hasPassword == hasKeyPair {
+			return fmt.Errorf("exactly one of root_password and key_pair must be set")
+		}
+	}
+
+	// the reserve_data_disk and dns_servers only take effect when the instance is reinstalled by changing image_id
+	if diff.Id() != "" && !diff.HasChange("image_id") {
+		for _, key := range []string{"reserve_data_disk", "dns_servers"} {
+			if diff.HasChange(key) {
+				return fmt.Errorf("%s can only be changed together with image_id, it is only used when the instance is reinstalled", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// instanceReinstallRefreshFunc will wait the instance stopped with the new image, the state of instance is stopped
+// both before and after reinstalling, so the image id is checked to make sure the reinstallation has been completed.
+// The image id rather than the basic image id is checked, the latter is the source image of custom image.
+func instanceReinstallRefreshFunc(client *UCloudClient, instanceId, imageId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := client.describeInstanceById(instanceId)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, statusPending, nil
+			}
+			return nil, "", err
+		}
+
+		state := strings.ToLower(instance.State)
+		if state == "install fail" {
+			return nil, "", fmt.Errorf("the instance %s is failed to reinstall with image %s", instanceId, imageId)
+		}
+
+		if state != statusStopped || instance.ImageId != imageId {
+			state = statusPending
+		}
+
+		return instance, state, nil
+	}
+}
+
 func instanceStateRefreshFunc(client *UCloudClient, instanceId, target string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		instance, err := client.describeInstanceById(instanceId)
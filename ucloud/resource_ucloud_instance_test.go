@@ -3,6 +3,7 @@ package ucloud
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -12,7 +13,42 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/uhost"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_instance", &resource.Sweeper{
+		Name: "ucloud_instance",
+		Dependencies: []string{
+			"ucloud_disk_attachment",
+			"ucloud_eip_association",
+			"ucloud_lb_attachment",
+		},
+		F: testSweepInstances,
+	})
+}
+
+func testSweepInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	instances, err := testSweepListInstances(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range instances {
+		log.Printf("[INFO] Destroying instance %s (%s)", item.UHostId, item.Name)
+		if err := testSweepDeleteResource(client, resourceUCloudInstance(), item.UHostId, nil); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudInstance_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	rInt := acctest.RandInt()
 	var instance uhost.UHostInstanceSet
 
@@ -55,6 +91,8 @@ func TestAccUCloudInstance_basic(t *testing.T) {
 }
 
 func TestAccUCloudInstance_vpc(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	rInt := acctest.RandInt()
 	var instance uhost.UHostInstanceSet
 
@@ -80,7 +118,38 @@ func TestAccUCloudInstance_vpc(t *testing.T) {
 	})
 }
 
+func TestAccUCloudInstance_privateIp(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+	var instance uhost.UHostInstanceSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_instance.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInstanceConfigPrivateIp(rInt),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("ucloud_instance.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_instance.foo", "name", "tf-acc-instance-private-ip"),
+					resource.TestCheckResourceAttr("ucloud_instance.foo", "private_ip", "192.168.1.100"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccUCloudInstance_size(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	rInt := acctest.RandInt()
 	var instance uhost.UHostInstanceSet
 
@@ -117,6 +186,201 @@ func TestAccUCloudInstance_size(t *testing.T) {
 	})
 }
 
+func TestAccUCloudInstance_reinstall(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+	var instance uhost.UHostInstanceSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_instance.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInstanceConfigReinstall(rInt, "^CentOS 7.[1-2] 64", true),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("ucloud_instance.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_instance.foo", "name", "tf-acc-instance-reinstall"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccInstanceConfigReinstall(rInt, "^CentOS 6.[5-9] 64", true),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("ucloud_instance.foo", &instance),
+					testAccCheckInstanceNotRecreated("ucloud_instance.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_instance.foo", "name", "tf-acc-instance-reinstall"),
+					resource.TestCheckResourceAttr("ucloud_instance.foo", "reserve_data_disk", "true"),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccInstanceConfigReinstall(rInt, "^CentOS 6.[5-9] 64", false),
+				ExpectError: regexp.MustCompile("reserve_data_disk can only be changed together with image_id"),
+			},
+		},
+	})
+}
+
+func TestAccUCloudInstance_reinstallCustomImage(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+	var instance uhost.UHostInstanceSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_instance.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInstanceConfigReinstallCustomImage(rInt, "${data.ucloud_images.default.images.0.id}"),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("ucloud_instance.foo", &instance),
+					resource.TestCheckResourceAttrPair("ucloud_instance.foo", "image_id", "data.ucloud_images.default", "images.0.id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccInstanceConfigReinstallCustomImage(rInt, "${ucloud_custom_image.foo.id}"),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceNotRecreated("ucloud_instance.foo", &instance),
+					testAccCheckInstanceExists("ucloud_instance.foo", &instance),
+					resource.TestCheckResourceAttrPair("ucloud_instance.foo", "image_id", "ucloud_custom_image.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUCloudInstance_keyPair(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+	var instance uhost.UHostInstanceSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_instance.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccInstanceConfigNoCredential,
+				ExpectError: regexp.MustCompile("exactly one of root_password and key_pair must be set"),
+			},
+
+			resource.TestStep{
+				Config: testAccInstanceConfigKeyPair(rInt),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("ucloud_instance.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_instance.foo", "name", "tf-acc-instance-key-pair"),
+					resource.TestCheckResourceAttrSet("ucloud_instance.foo", "key_pair"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUCloudInstance_userData(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+	var instance uhost.UHostInstanceSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_instance.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInstanceConfigUserData(rInt),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("ucloud_instance.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_instance.foo", "name", "tf-acc-instance-user-data"),
+					resource.TestCheckResourceAttr("ucloud_instance.foo", "user_data", "#!/bin/bash\necho hello > /tmp/hello\n"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUCloudInstance_backupMode(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+	var instance uhost.UHostInstanceSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_instance.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInstanceConfigBackupMode(rInt, "none"),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("ucloud_instance.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_instance.foo", "backup_mode", "none"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccInstanceConfigBackupMode(rInt, "data_ark"),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("ucloud_instance.foo", &instance),
+					testAccCheckInstanceNotRecreated("ucloud_instance.foo", &instance),
+					resource.TestCheckResourceAttr("ucloud_instance.foo", "backup_mode", "data_ark"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceNotRecreated(n string, instance *uhost.UHostInstanceSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID != instance.UHostId {
+			return fmt.Errorf("instance has been recreated, expected %s, got %s", instance.UHostId, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckInstanceExists(n string, instance *uhost.UHostInstanceSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -286,6 +550,55 @@ resource "ucloud_instance" "foo" {
 }`, rInt)
 }
 
+func testAccInstanceConfigPrivateIp(rInt int) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_vpc" "default" {
+  name        = "tf-acc-instance-private-ip"
+  tag         = "tf-acc"
+  cidr_blocks = ["192.168.0.0/16"]
+}
+
+resource "ucloud_subnet" "default" {
+  name       = "tf-acc-instance-private-ip"
+  tag        = "tf-acc"
+  cidr_block = "192.168.1.0/24"
+  vpc_id     = "${ucloud_vpc.default.id}"
+}
+
+resource "ucloud_security_group" "default" {
+  name = "tf-acc-instance-private-ip-%d"
+  tag  = "tf-acc"
+
+  rules {
+    port_range = "80"
+    protocol   = "tcp"
+    cidr_block = "192.168.0.0/16"
+    policy     = "accept"
+  }
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  security_group    = "${ucloud_security_group.default.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-instance-private-ip"
+  tag               = "tf-acc"
+  vpc_id            = "${ucloud_vpc.default.id}"
+  subnet_id         = "${ucloud_subnet.default.id}"
+  private_ip        = "192.168.1.100"
+}`, rInt)
+}
+
 func testAccInstancesConfigSize(rInt int) string {
 	return fmt.Sprintf(`
 data "ucloud_zones" "default" {}
@@ -385,3 +698,206 @@ resource "ucloud_instance" "foo" {
 }
 `, rInt)
 }
+
+func testAccInstanceConfigReinstall(rInt int, imageNameRegex string, reserveDataDisk bool) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "%s"
+  image_type        = "base"
+}
+
+resource "ucloud_security_group" "default" {
+  name = "tf-acc-instance-reinstall-%d"
+  tag  = "tf-acc"
+
+  rules {
+    port_range = "80"
+    protocol   = "tcp"
+    cidr_block = "192.168.0.0/16"
+    policy     = "accept"
+  }
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  security_group    = "${ucloud_security_group.default.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-instance-reinstall"
+  tag               = "tf-acc"
+  data_disk_size    = 50
+  reserve_data_disk = %t
+}`, imageNameRegex, rInt, reserveDataDisk)
+}
+
+func testAccInstanceConfigReinstallCustomImage(rInt int, imageId string) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_security_group" "default" {
+  name = "tf-acc-instance-reinstall-custom-image-%d"
+  tag  = "tf-acc"
+
+  rules {
+    port_range = "80"
+    protocol   = "tcp"
+    cidr_block = "192.168.0.0/16"
+    policy     = "accept"
+  }
+}
+
+resource "ucloud_instance" "bar" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  security_group    = "${ucloud_security_group.default.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-instance-reinstall-custom-image-source"
+  tag               = "tf-acc"
+}
+
+resource "ucloud_custom_image" "foo" {
+  instance_id = "${ucloud_instance.bar.id}"
+  name        = "tf-acc-instance-reinstall-custom-image"
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "%s"
+  security_group    = "${ucloud_security_group.default.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-instance-reinstall-custom-image"
+  tag               = "tf-acc"
+}`, rInt, imageId)
+}
+
+func testAccInstanceConfigKeyPair(rInt int) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_security_group" "default" {
+  name = "tf-acc-instance-key-pair-%d"
+  tag  = "tf-acc"
+
+  rules {
+    port_range = "22"
+    protocol   = "tcp"
+    cidr_block = "192.168.0.0/16"
+    policy     = "accept"
+  }
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  security_group    = "${ucloud_security_group.default.id}"
+  instance_type     = "n-highcpu-1"
+  key_pair          = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC7p9a2dX5nJ3V+q8yKtfZ4Xu5Wj0aCqkP3sB1tF2xQ tf-acc"
+  name              = "tf-acc-instance-key-pair"
+  tag               = "tf-acc"
+}`, rInt)
+}
+
+const testAccInstanceConfigNoCredential = `
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  instance_type     = "n-highcpu-1"
+  name              = "tf-acc-instance-no-credential"
+  tag               = "tf-acc"
+}`
+
+func testAccInstanceConfigUserData(rInt int) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_security_group" "default" {
+  name = "tf-acc-instance-user-data-%d"
+  tag  = "tf-acc"
+
+  rules {
+    port_range = "80"
+    protocol   = "tcp"
+    cidr_block = "192.168.0.0/16"
+    policy     = "accept"
+  }
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  security_group    = "${ucloud_security_group.default.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-instance-user-data"
+  tag               = "tf-acc"
+  user_data         = "#!/bin/bash\necho hello > /tmp/hello\n"
+}`, rInt)
+}
+
+func testAccInstanceConfigBackupMode(rInt int, backupMode string) string {
+	return fmt.Sprintf(`
+data "ucloud_zones" "default" {}
+
+data "ucloud_images" "default" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  name_regex        = "^CentOS 7.[1-2] 64"
+  image_type        = "base"
+}
+
+resource "ucloud_security_group" "default" {
+  name = "tf-acc-instance-backup-mode-%d"
+  tag  = "tf-acc"
+
+  rules {
+    port_range = "80"
+    protocol   = "tcp"
+    cidr_block = "192.168.0.0/16"
+    policy     = "accept"
+  }
+}
+
+resource "ucloud_instance" "foo" {
+  availability_zone = "${data.ucloud_zones.default.zones.0.id}"
+  image_id          = "${data.ucloud_images.default.images.0.id}"
+  security_group    = "${ucloud_security_group.default.id}"
+  instance_type     = "n-highcpu-1"
+  root_password     = "wA1234567"
+  name              = "tf-acc-instance-backup-mode"
+  tag               = "tf-acc"
+  boot_disk_type    = "local_normal"
+  data_disk_type    = "local_normal"
+  backup_mode       = "%s"
+}`, rInt, backupMode)
+}
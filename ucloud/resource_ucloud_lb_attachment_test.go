@@ -11,7 +11,48 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/ulb"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_lb_attachment", &resource.Sweeper{
+		Name: "ucloud_lb_attachment",
+		Dependencies: []string{
+			"ucloud_lb_rule",
+		},
+		F: testSweepLBAttachments,
+	})
+}
+
+func testSweepLBAttachments(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	lbs, err := testSweepListLBs(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, lb := range lbs {
+		for _, vserver := range lb.VServerSet {
+			for _, item := range vserver.BackendSet {
+				log.Printf("[INFO] Destroying lb attachment %s of lb listener %s", item.BackendId, vserver.VServerId)
+				attributes := map[string]interface{}{
+					"load_balancer_id": lb.ULBId,
+					"listener_id":      vserver.VServerId,
+				}
+				if err := testSweepDeleteResource(client, resourceUCloudLBAttachment(), item.BackendId, attributes); err != nil {
+					errs.add(err)
+				}
+			}
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudLBAttachment_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var lbSet ulb.ULBSet
 	var vserverSet ulb.ULBVServerSet
 	var instance uhost.UHostInstanceSet
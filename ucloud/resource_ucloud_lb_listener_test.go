@@ -10,7 +10,44 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/ulb"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_lb_listener", &resource.Sweeper{
+		Name: "ucloud_lb_listener",
+		Dependencies: []string{
+			"ucloud_lb_attachment",
+			"ucloud_lb_rule",
+		},
+		F: testSweepLBListeners,
+	})
+}
+
+func testSweepLBListeners(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	lbs, err := testSweepListLBs(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, lb := range lbs {
+		for _, item := range lb.VServerSet {
+			log.Printf("[INFO] Destroying lb listener %s of lb %s", item.VServerId, lb.ULBId)
+			attributes := map[string]interface{}{"load_balancer_id": lb.ULBId}
+			if err := testSweepDeleteResource(client, resourceUCloudLBListener(), item.VServerId, attributes); err != nil {
+				errs.add(err)
+			}
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudLBListener_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var lbSet ulb.ULBSet
 	var vserverSet ulb.ULBVServerSet
 	resource.ParallelTest(t, resource.TestCase{
@@ -11,7 +11,50 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/ulb"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_lb_rule", &resource.Sweeper{
+		Name: "ucloud_lb_rule",
+		F:    testSweepLBRules,
+	})
+}
+
+func testSweepLBRules(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	lbs, err := testSweepListLBs(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, lb := range lbs {
+		for _, vserver := range lb.VServerSet {
+			for _, item := range vserver.PolicySet {
+				// the default policy is owned by lb listener
+				if item.PolicyType == "Default" {
+					continue
+				}
+
+				log.Printf("[INFO] Destroying lb rule %s of lb listener %s", item.PolicyId, vserver.VServerId)
+				attributes := map[string]interface{}{
+					"load_balancer_id": lb.ULBId,
+					"listener_id":      vserver.VServerId,
+				}
+				if err := testSweepDeleteResource(client, resourceUCloudLBRule(), item.PolicyId, attributes); err != nil {
+					errs.add(err)
+				}
+			}
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudLBRule_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var lbSet ulb.ULBSet
 	var vserverSet ulb.ULBVServerSet
 	var instance uhost.UHostInstanceSet
@@ -10,7 +10,41 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/ulb"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_lb", &resource.Sweeper{
+		Name: "ucloud_lb",
+		Dependencies: []string{
+			"ucloud_lb_listener",
+			"ucloud_eip_association",
+		},
+		F: testSweepLBs,
+	})
+}
+
+func testSweepLBs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	lbs, err := testSweepListLBs(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range lbs {
+		log.Printf("[INFO] Destroying lb %s (%s)", item.ULBId, item.Name)
+		if err := testSweepDeleteResource(client, resourceUCloudLB(), item.ULBId, nil); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudLB_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var lbSet ulb.ULBSet
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -9,12 +9,18 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
 	"github.com/ucloud/ucloud-sdk-go/ucloud"
+	uerr "github.com/ucloud/ucloud-sdk-go/ucloud/error"
 )
 
 // security policy use ICMP, GRE packet with port is not supported
 var portIndependentProtocols = []string{"icmp", "gre"}
 
+// securityGroupInitialRule is used to create the security group whose rules are managed by ucloud_security_group_rule,
+// it is removed before any resource is attached, so the drop policy has no effect.
+const securityGroupInitialRule = "ICMP||0.0.0.0/0|DROP|LOW"
+
 func resourceUCloudSecurityGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceUCloudSecurityGroupCreate,
@@ -25,6 +31,8 @@ func resourceUCloudSecurityGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceUCloudSecurityGroupCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -33,9 +41,19 @@ func resourceUCloudSecurityGroup() *schema.Resource {
 				ValidateFunc: validateName,
 			},
 
+			"rules_management": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "inline",
+				ValidateFunc: validation.StringInSlice([]string{
+					"inline",
+					"external",
+				}, false),
+			},
+
 			"rules": {
 				Type:     schema.TypeSet,
-				Required: true,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"port_range": &schema.Schema{
@@ -112,6 +130,34 @@ func resourceUCloudSecurityGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"attached_resources": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -122,7 +168,14 @@ func resourceUCloudSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 
 	req := conn.NewCreateFirewallRequest()
 	req.Name = ucloud.String(d.Get("name").(string))
-	req.Rule = buildRuleParameter(d.Get("rules"))
+
+	// at least one rule is required to create security group, the initial rule is removed after created
+	// when the rules are managed by ucloud_security_group_rule
+	if isSecurityGroupRulesInline(d) {
+		req.Rule = buildRuleParameter(d.Get("rules"))
+	} else {
+		req.Rule = []string{securityGroupInitialRule}
+	}
 
 	// if tag is empty string, use default tag
 	if v, ok := d.GetOk("tag"); ok {
@@ -150,6 +203,12 @@ func resourceUCloudSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error on waiting for security group %s complete creating, %s", d.Id(), err)
 	}
 
+	if !isSecurityGroupRulesInline(d) {
+		if err := updateSecurityGroupRules(client, d.Id(), []string{}); err != nil {
+			return fmt.Errorf("error on removing the initial rule of security group %s, %s", d.Id(), err)
+		}
+	}
+
 	return resourceUCloudSecurityGroupRead(d, meta)
 }
 
@@ -159,7 +218,12 @@ func resourceUCloudSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 
 	d.Partial(true)
 
-	if d.HasChange("rules") && !d.IsNewResource() {
+	// the rules managed by external resource are not touched at here
+	if d.HasChange("rules") && !d.IsNewResource() && isSecurityGroupRulesInline(d) {
+		// the rules of security group is serialized with the security group rule resource
+		ucloudMutexKV.Lock(d.Id())
+		defer ucloudMutexKV.Unlock(d.Id())
+
 		req := conn.NewUpdateFirewallRequest()
 		req.FWId = ucloud.String(d.Id())
 		req.Rule = buildRuleParameter(d.Get("rules"))
@@ -228,6 +292,20 @@ func resourceUCloudSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 	return resourceUCloudSecurityGroupRead(d, meta)
 }
 
+func resourceUCloudSecurityGroupCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	rulesCount := d.Get("rules").(*schema.Set).Len()
+	if d.Get("rules_management").(string) == "external" {
+		// the rules are managed by ucloud_security_group_rule, which would be overwritten by each other
+		if rulesCount > 0 {
+			return fmt.Errorf("rules can not be set when rules_management is external, please use ucloud_security_group_rule instead")
+		}
+	} else if rulesCount == 0 {
+		return fmt.Errorf("rules is required when rules_management is inline")
+	}
+
+	return nil
+}
+
 func resourceUCloudSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*UCloudClient)
 	sgSet, err := client.describeFirewallById(d.Id())
@@ -245,18 +323,35 @@ func resourceUCloudSecurityGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("remark", sgSet.Remark)
 	d.Set("create_time", timestampToString(sgSet.CreateTime))
 
-	rules := []map[string]interface{}{}
-	for _, item := range sgSet.Rule {
-		rules = append(rules, map[string]interface{}{
-			"port_range": item.DstPort,
-			"protocol":   upperCvt.convert(item.ProtocolType),
-			"cidr_block": item.SrcIP,
-			"policy":     upperCvt.convert(item.RuleAction),
-			"priority":   upperCvt.convert(item.Priority),
+	// the rules_management is missing after importing, it is inline as default
+	if _, ok := d.GetOk("rules_management"); !ok {
+		d.Set("rules_management", "inline")
+	}
+
+	if isSecurityGroupRulesInline(d) {
+		if err := d.Set("rules", flattenRuleSet(sgSet.Rule)); err != nil {
+			return err
+		}
+	}
+
+	// the security group is only removed when it is not found by DescribeFirewall,
+	// there is no resource attached if the resources of security group are not found.
+	resources, err := client.describeFirewallResourcesById(d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error on reading attached resources of security group %s, %s", d.Id(), err)
+	}
+
+	attachedResources := []map[string]interface{}{}
+	for _, item := range resources {
+		attachedResources = append(attachedResources, map[string]interface{}{
+			"resource_id":   item.ResourceID,
+			"resource_type": securityGroupResourceTypeCvt.unconvert(item.ResourceType),
+			"name":          item.Name,
+			"private_ip":    item.PrivateIP,
 		})
 	}
 
-	if err := d.Set("rules", rules); err != nil {
+	if err := d.Set("attached_resources", attachedResources); err != nil {
 		return err
 	}
 
@@ -272,6 +367,10 @@ func resourceUCloudSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
 		if _, err := conn.DeleteFirewall(req); err != nil {
+			// the security group may be still used by the resource which is being deleted at the same time
+			if uErr, ok := err.(uerr.Error); ok && uErr.Code() == 54004 {
+				return resource.RetryableError(fmt.Errorf("error on deleting security group %s, %s", d.Id(), err))
+			}
 			return resource.NonRetryableError(fmt.Errorf("error on deleting security group %s, %s", d.Id(), err))
 		}
 
@@ -316,20 +415,38 @@ func resourceucloudSecurityGroupRuleHash(v interface{}) int {
 func buildRuleParameter(iface interface{}) []string {
 	rules := []string{}
 	for _, item := range iface.(*schema.Set).List() {
-		rule := item.(map[string]interface{})
-		port := rule["port_range"]
-		if v := rule["protocol"].(string); shouldIgnorePort(v) {
-			port = ""
-		}
-		s := fmt.Sprintf(
-			"%s|%s|%s|%s|%s",
-			upperCvt.unconvert(rule["protocol"].(string)),
-			port,
-			rule["cidr_block"],
-			upperCvt.unconvert(rule["policy"].(string)),
-			upperCvt.unconvert(rule["priority"].(string)),
-		)
-		rules = append(rules, s)
+		rules = append(rules, buildRuleString(item.(map[string]interface{})))
+	}
+	return rules
+}
+
+// buildRuleString will build one rule of security group as the parameter of api, such as "TCP|80|0.0.0.0/0|ACCEPT|HIGH"
+func buildRuleString(rule map[string]interface{}) string {
+	port := rule["port_range"]
+	if v := rule["protocol"].(string); shouldIgnorePort(v) {
+		port = ""
+	}
+	return fmt.Sprintf(
+		"%s|%s|%s|%s|%s",
+		upperCvt.unconvert(rule["protocol"].(string)),
+		port,
+		rule["cidr_block"],
+		upperCvt.unconvert(rule["policy"].(string)),
+		upperCvt.unconvert(rule["priority"].(string)),
+	)
+}
+
+// flattenRuleSet is the reverse of buildRuleParameter, which converts the rules of security group to the schema of rules
+func flattenRuleSet(ruleSet []unet.FirewallRuleSet) []map[string]interface{} {
+	rules := []map[string]interface{}{}
+	for _, item := range ruleSet {
+		rules = append(rules, map[string]interface{}{
+			"port_range": item.DstPort,
+			"protocol":   upperCvt.convert(item.ProtocolType),
+			"cidr_block": item.SrcIP,
+			"policy":     upperCvt.convert(item.RuleAction),
+			"priority":   upperCvt.convert(item.Priority),
+		})
 	}
 	return rules
 }
@@ -355,6 +472,11 @@ func securityWaitForState(client *UCloudClient, sgId string) *resource.StateChan
 	}
 }
 
+// isSecurityGroupRulesInline will check if the rules of security group is managed by the rules argument
+func isSecurityGroupRulesInline(d *schema.ResourceData) bool {
+	return d.Get("rules_management").(string) != "external"
+}
+
 func shouldIgnorePort(protocol string) bool {
 	return checkStringIn(protocol, portIndependentProtocols) == nil
 }
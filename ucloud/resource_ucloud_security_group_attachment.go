@@ -0,0 +1,205 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func resourceUCloudSecurityGroupAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUCloudSecurityGroupAttachmentCreate,
+		Read:   resourceUCloudSecurityGroupAttachmentRead,
+		Delete: resourceUCloudSecurityGroupAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"instance",
+					"lb",
+					"physical_instance",
+					"nat_gateway",
+				}, false),
+			},
+
+			"resource_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"previous_security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUCloudSecurityGroupAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	sgId := d.Get("security_group_id").(string)
+	resourceType := securityGroupResourceTypeCvt.convert(d.Get("resource_type").(string))
+	resourceId := d.Get("resource_id").(string)
+
+	// the previous security group is attached to the resource again when this attachment is deleted
+	previous, err := client.describeFirewallByResource(resourceType, resourceId)
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error on reading the security group of %s when creating security group attachment, %s", resourceId, err)
+	}
+
+	if previous != nil {
+		d.Set("previous_security_group_id", previous.FWId)
+	}
+
+	req := conn.NewGrantFirewallRequest()
+	req.FWId = ucloud.String(sgId)
+	req.ResourceType = ucloud.String(resourceType)
+	req.ResourceId = ucloud.String(resourceId)
+
+	_, err = conn.GrantFirewall(req)
+	if err != nil {
+		return fmt.Errorf("error on creating security group attachment, %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("security_group#%s:%s#%s", sgId, resourceType, resourceId))
+
+	// after grant security group we need to wait it completed
+	if _, err = securityGroupAttachmentWaitForState(client, sgId, resourceId).WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for security group attachment is completed when creating %s, %s", d.Id(), err)
+	}
+
+	return resourceUCloudSecurityGroupAttachmentRead(d, meta)
+}
+
+func resourceUCloudSecurityGroupAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	assoc, err := parseAssociationInfo(d.Id())
+	if err != nil {
+		return fmt.Errorf("error on parsing security group attachment %s, %s", d.Id(), err)
+	}
+
+	resourceSet, err := client.describeSecurityGroupAttachmentById(assoc.PrimaryId, assoc.ResourceId)
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading security group attachment %s, %s", d.Id(), err)
+	}
+
+	d.Set("security_group_id", assoc.PrimaryId)
+	d.Set("resource_type", securityGroupResourceTypeCvt.unconvert(assoc.ResourceType))
+	d.Set("resource_id", resourceSet.ResourceID)
+
+	return nil
+}
+
+func resourceUCloudSecurityGroupAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	assoc, err := parseAssociationInfo(d.Id())
+	if err != nil {
+		return fmt.Errorf("error on parsing security group attachment %s, %s", d.Id(), err)
+	}
+
+	// the security group has been replaced by another one, there is nothing to detach
+	if _, err := client.describeSecurityGroupAttachmentById(assoc.PrimaryId, assoc.ResourceId); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("error on reading security group attachment when deleting %s, %s", d.Id(), err)
+	}
+
+	// [API-STYLE] There is no api to revoke security group from resource, the resource is always protected by one security group,
+	// so the previous security group is attached again, or the default security group if the previous one is not found.
+	sgId, err := describeSecurityGroupToRestore(client, d.Get("previous_security_group_id").(string))
+	if err != nil {
+		return fmt.Errorf("error on reading the security group to restore when deleting security group attachment %s, %s", d.Id(), err)
+	}
+
+	// the security group has been attached before this attachment is created
+	if sgId == assoc.PrimaryId {
+		return nil
+	}
+
+	req := conn.NewGrantFirewallRequest()
+	req.FWId = ucloud.String(sgId)
+	req.ResourceType = ucloud.String(assoc.ResourceType)
+	req.ResourceId = ucloud.String(assoc.ResourceId)
+
+	if _, err := conn.GrantFirewall(req); err != nil {
+		return fmt.Errorf("error on deleting security group attachment %s, %s", d.Id(), err)
+	}
+
+	if _, err := securityGroupAttachmentWaitForState(client, sgId, assoc.ResourceId).WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for security group %s is restored when deleting security group attachment %s, %s", sgId, d.Id(), err)
+	}
+
+	return nil
+}
+
+// describeSecurityGroupToRestore will returns the previous security group if it is still existed,
+// otherwise the default security group of project is returned.
+func describeSecurityGroupToRestore(client *UCloudClient, previousId string) (string, error) {
+	if previousId != "" {
+		sgSet, err := client.describeFirewallById(previousId)
+		if err == nil {
+			return sgSet.FWId, nil
+		}
+
+		if !isNotFoundError(err) {
+			return "", err
+		}
+		log.Printf("[WARN] the previous security group %s is not found, the default security group is used instead", previousId)
+	}
+
+	sgSet, err := client.describeDefaultFirewall()
+	if err != nil {
+		return "", err
+	}
+	return sgSet.FWId, nil
+}
+
+// securityGroupAttachmentWaitForState will wait until the security group is attached to the resource
+func securityGroupAttachmentWaitForState(client *UCloudClient, sgId, resourceId string) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending:    []string{statusPending},
+		Target:     []string{statusInitialized},
+		Timeout:    5 * time.Minute,
+		Delay:      2 * time.Second,
+		MinTimeout: 1 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resourceSet, err := client.describeSecurityGroupAttachmentById(sgId, resourceId)
+			if err != nil {
+				if isNotFoundError(err) {
+					return nil, statusPending, nil
+				}
+				return nil, "", err
+			}
+
+			return resourceSet, statusInitialized, nil
+		},
+	}
+}
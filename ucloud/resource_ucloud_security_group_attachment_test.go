@@ -0,0 +1,288 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/ucloud/ucloud-sdk-go/services/ulb"
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
+)
+
+func init() {
+	resource.AddTestSweepers("ucloud_security_group_attachment", &resource.Sweeper{
+		Name: "ucloud_security_group_attachment",
+		Dependencies: []string{
+			"ucloud_instance",
+			"ucloud_lb",
+		},
+		F: testSweepSecurityGroupAttachments,
+	})
+}
+
+// testSweepSecurityGroupAttachments will destroy the attachments of security groups,
+// the attached resources are destroyed by the dependencies of sweeper at first,
+// and the others are detached by attaching the default security group.
+func testSweepSecurityGroupAttachments(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sgs, err := testSweepListSecurityGroups(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, sg := range sgs {
+		resources, err := client.describeFirewallResourcesById(sg.FWId)
+		if err != nil {
+			errs.add(fmt.Errorf("error on reading resources of security group %s, %s", sg.FWId, err))
+			continue
+		}
+
+		for _, item := range resources {
+			id := fmt.Sprintf("security_group#%s:%s#%s", sg.FWId, item.ResourceType, item.ResourceID)
+			log.Printf("[INFO] Destroying security group attachment %s", id)
+			if err := testSweepDeleteResource(client, resourceUCloudSecurityGroupAttachment(), id, nil); err != nil {
+				errs.add(err)
+			}
+		}
+	}
+	return errs.err()
+}
+
+func TestAccUCloudSecurityGroupAttachment_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+	var lbSet ulb.ULBSet
+	var resourceSet unet.ResourceSet
+	var previousId string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_security_group_attachment.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSecurityGroupAttachmentDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSecurityGroupAttachmentConfig(rInt, "foo"),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBExists("ucloud_lb.foo", &lbSet),
+					testAccCheckSecurityGroupAttachmentExists("ucloud_security_group_attachment.foo", &resourceSet),
+					testAccCheckSecurityGroupAttachmentAttributes(&resourceSet),
+					resource.TestCheckResourceAttr("ucloud_security_group_attachment.foo", "resource_type", "lb"),
+					resource.TestCheckResourceAttrPair("ucloud_security_group_attachment.foo", "security_group_id", "ucloud_security_group.foo", "id"),
+					testAccCheckSecurityGroupAttachmentPrevious("ucloud_security_group_attachment.foo", &previousId),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccSecurityGroupAttachmentConfig(rInt, "bar"),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBExists("ucloud_lb.foo", &lbSet),
+					testAccCheckSecurityGroupAttachmentExists("ucloud_security_group_attachment.foo", &resourceSet),
+					testAccCheckSecurityGroupAttachmentAttributes(&resourceSet),
+					resource.TestCheckResourceAttr("ucloud_security_group_attachment.foo", "resource_type", "lb"),
+					resource.TestCheckResourceAttrPair("ucloud_security_group_attachment.foo", "security_group_id", "ucloud_security_group.bar", "id"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccSecurityGroupAttachmentConfig(rInt, "bar"),
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ucloud_security_group.foo", "attached_resources.#", "0"),
+					resource.TestCheckResourceAttr("ucloud_security_group.bar", "attached_resources.#", "1"),
+					resource.TestCheckResourceAttr("ucloud_security_group.bar", "attached_resources.0.resource_type", "lb"),
+					resource.TestCheckResourceAttrPair("ucloud_security_group.bar", "attached_resources.0.resource_id", "ucloud_lb.foo", "id"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccSecurityGroupAttachmentConfigDetached(rInt),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBExists("ucloud_lb.foo", &lbSet),
+					testAccCheckSecurityGroupOfResource("ucloud_lb.foo", "ULB", &previousId),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupAttachmentExists(n string, resourceSet *unet.ResourceSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("security group attachment id is empty")
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		ptr, err := client.describeSecurityGroupAttachmentById(
+			rs.Primary.Attributes["security_group_id"],
+			rs.Primary.Attributes["resource_id"],
+		)
+
+		log.Printf("[INFO] security group attachment id %#v", rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*resourceSet = *ptr
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupAttachmentPrevious(n string, previousId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		*previousId = rs.Primary.Attributes["previous_security_group_id"]
+		if *previousId == "" {
+			return fmt.Errorf("previous security group id is empty")
+		}
+		return nil
+	}
+}
+
+// testAccCheckSecurityGroupOfResource will check the security group attached to the resource,
+// the previous security group is attached again after the attachment is destroyed.
+func testAccCheckSecurityGroupOfResource(n, resourceType string, sgId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		sgSet, err := client.describeFirewallByResource(resourceType, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if sgSet.FWId != *sgId {
+			return fmt.Errorf("expected security group %s of %s, got %s", *sgId, rs.Primary.ID, sgSet.FWId)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupAttachmentAttributes(resourceSet *unet.ResourceSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if resourceSet.ResourceID == "" {
+			return fmt.Errorf("security group attachment resource id is empty")
+		}
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupAttachmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_security_group_attachment" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		d, err := client.describeSecurityGroupAttachmentById(
+			rs.Primary.Attributes["security_group_id"],
+			rs.Primary.Attributes["resource_id"],
+		)
+
+		// Verify the error is what we want
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if d.ResourceID != "" {
+			return fmt.Errorf("security group attachment still exist")
+		}
+	}
+
+	return nil
+}
+
+func testAccSecurityGroupAttachmentConfig(rInt int, sgName string) string {
+	return fmt.Sprintf(`
+resource "ucloud_security_group" "foo" {
+	name = "tf-acc-security-group-attachment-%d"
+	tag  = "tf-acc"
+	rules {
+		port_range = "80"
+		protocol   = "tcp"
+		cidr_block = "0.0.0.0/0"
+	}
+}
+
+resource "ucloud_security_group" "bar" {
+	name = "tf-acc-security-group-attachment-%d-two"
+	tag  = "tf-acc"
+	rules {
+		port_range = "443"
+		protocol   = "tcp"
+		cidr_block = "0.0.0.0/0"
+	}
+}
+
+resource "ucloud_lb" "foo" {
+	name = "tf-acc-security-group-attachment"
+	tag  = "tf-acc"
+}
+
+resource "ucloud_security_group_attachment" "foo" {
+	security_group_id = "${ucloud_security_group.%s.id}"
+	resource_type     = "lb"
+	resource_id       = "${ucloud_lb.foo.id}"
+}`, rInt, rInt, sgName)
+}
+
+func testAccSecurityGroupAttachmentConfigDetached(rInt int) string {
+	return fmt.Sprintf(`
+resource "ucloud_security_group" "foo" {
+	name = "tf-acc-security-group-attachment-%d"
+	tag  = "tf-acc"
+	rules {
+		port_range = "80"
+		protocol   = "tcp"
+		cidr_block = "0.0.0.0/0"
+	}
+}
+
+resource "ucloud_security_group" "bar" {
+	name = "tf-acc-security-group-attachment-%d-two"
+	tag  = "tf-acc"
+	rules {
+		port_range = "443"
+		protocol   = "tcp"
+		cidr_block = "0.0.0.0/0"
+	}
+}
+
+resource "ucloud_lb" "foo" {
+	name = "tf-acc-security-group-attachment"
+	tag  = "tf-acc"
+}`, rInt, rInt)
+}
@@ -0,0 +1,255 @@
+package ucloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func resourceUCloudSecurityGroupRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUCloudSecurityGroupRuleCreate,
+		Read:   resourceUCloudSecurityGroupRuleRead,
+		Delete: resourceUCloudSecurityGroupRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port_range": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSecurityGroupPort,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if v, ok := d.GetOk("protocol"); ok && shouldIgnorePort(v.(string)) {
+						return true
+					}
+					return false
+				},
+			},
+
+			"protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "tcp",
+				ValidateFunc: validation.StringInSlice([]string{
+					"tcp",
+					"udp",
+					"gre",
+					"icmp",
+				}, false),
+			},
+
+			"cidr_block": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "0.0.0.0/0",
+				ValidateFunc: validation.CIDRNetwork(0, 32),
+			},
+
+			"policy": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "accept",
+				ValidateFunc: validation.StringInSlice([]string{
+					"accept",
+					"drop",
+				}, false),
+			},
+
+			"priority": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "high",
+				ValidateFunc: validation.StringInSlice([]string{
+					"high",
+					"medium",
+					"low",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceUCloudSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	sgId := d.Get("security_group_id").(string)
+	protocol := d.Get("protocol").(string)
+
+	if _, ok := d.GetOk("port_range"); !ok && !shouldIgnorePort(protocol) {
+		return fmt.Errorf("error on creating security group rule, port_range is required when protocol is %s", protocol)
+	}
+
+	rule := buildRuleString(map[string]interface{}{
+		"port_range": d.Get("port_range").(string),
+		"protocol":   protocol,
+		"cidr_block": d.Get("cidr_block").(string),
+		"policy":     d.Get("policy").(string),
+		"priority":   d.Get("priority").(string),
+	})
+
+	// the rules of security group is read-modify-write, so it is serialized by security group
+	ucloudMutexKV.Lock(sgId)
+	defer ucloudMutexKV.Unlock(sgId)
+
+	rules, err := describeSecurityGroupRules(client, sgId)
+	if err != nil {
+		return fmt.Errorf("error on reading security group %s when creating security group rule, %s", sgId, err)
+	}
+
+	if isStringIn(rule, rules) {
+		return fmt.Errorf("error on creating security group rule, the rule %s already exists in security group %s", rule, sgId)
+	}
+
+	if err := updateSecurityGroupRules(client, sgId, append(rules, rule)); err != nil {
+		return fmt.Errorf("error on creating security group rule, %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", sgId, rule))
+
+	return resourceUCloudSecurityGroupRuleRead(d, meta)
+}
+
+func resourceUCloudSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	sgId, rule, err := parseSecurityGroupRuleId(d.Id())
+	if err != nil {
+		return fmt.Errorf("error on parsing security group rule %s, %s", d.Id(), err)
+	}
+
+	rules, err := describeSecurityGroupRules(client, sgId)
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading security group rule %s, %s", d.Id(), err)
+	}
+
+	if !isStringIn(buildRuleString(rule), rules) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("security_group_id", sgId)
+	d.Set("protocol", rule["protocol"])
+	d.Set("cidr_block", rule["cidr_block"])
+	d.Set("policy", rule["policy"])
+	d.Set("priority", rule["priority"])
+
+	if !shouldIgnorePort(rule["protocol"].(string)) {
+		d.Set("port_range", rule["port_range"])
+	}
+
+	return nil
+}
+
+func resourceUCloudSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	sgId, rule, err := parseSecurityGroupRuleId(d.Id())
+	if err != nil {
+		return fmt.Errorf("error on parsing security group rule %s, %s", d.Id(), err)
+	}
+
+	ucloudMutexKV.Lock(sgId)
+	defer ucloudMutexKV.Unlock(sgId)
+
+	rules, err := describeSecurityGroupRules(client, sgId)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("error on reading security group %s when deleting security group rule %s, %s", sgId, d.Id(), err)
+	}
+
+	ruleStr := buildRuleString(rule)
+	remains := []string{}
+	for _, item := range rules {
+		if item != ruleStr {
+			remains = append(remains, item)
+		}
+	}
+
+	if len(remains) == len(rules) {
+		return nil
+	}
+
+	if err := updateSecurityGroupRules(client, sgId, remains); err != nil {
+		return fmt.Errorf("error on deleting security group rule %s, %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// describeSecurityGroupRules will returns the rules of security group as the parameter of api
+func describeSecurityGroupRules(client *UCloudClient, sgId string) ([]string, error) {
+	sgSet, err := client.describeFirewallById(sgId)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := []string{}
+	for _, item := range flattenRuleSet(sgSet.Rule) {
+		rules = append(rules, buildRuleString(item))
+	}
+	return rules, nil
+}
+
+// updateSecurityGroupRules will replace all of the rules of security group and wait it completed
+func updateSecurityGroupRules(client *UCloudClient, sgId string, rules []string) error {
+	conn := client.unetconn
+
+	req := conn.NewUpdateFirewallRequest()
+	req.FWId = ucloud.String(sgId)
+	req.Rule = rules
+
+	if _, err := conn.UpdateFirewall(req); err != nil {
+		return fmt.Errorf("error on %s to security group %s, %s", "UpdateFirewall", sgId, err)
+	}
+
+	stateConf := securityWaitForState(client, sgId)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for %s complete to security group %s, %s", "UpdateFirewall", sgId, err)
+	}
+
+	return nil
+}
+
+// parseSecurityGroupRuleId will parse the id of security group rule,
+// such as "firewall-xxx:TCP|80|0.0.0.0/0|ACCEPT|HIGH" is the rule of security group "firewall-xxx".
+func parseSecurityGroupRuleId(id string) (string, map[string]interface{}, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", nil, fmt.Errorf("invalid identity of security group rule")
+	}
+
+	fields := strings.Split(parts[1], "|")
+	if len(fields) != 5 {
+		return "", nil, fmt.Errorf("invalid identity of security group rule")
+	}
+
+	rule := map[string]interface{}{
+		"protocol":   upperCvt.convert(fields[0]),
+		"port_range": fields[1],
+		"cidr_block": fields[2],
+		"policy":     upperCvt.convert(fields[3]),
+		"priority":   upperCvt.convert(fields[4]),
+	}
+	return parts[0], rule, nil
+}
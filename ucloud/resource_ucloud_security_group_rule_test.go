@@ -0,0 +1,292 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("ucloud_security_group_rule", &resource.Sweeper{
+		Name: "ucloud_security_group_rule",
+		F:    testSweepSecurityGroupRules,
+	})
+}
+
+func testSweepSecurityGroupRules(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sgs, err := testSweepListSecurityGroups(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, sg := range sgs {
+		for _, rule := range flattenRuleSet(sg.Rule) {
+			id := fmt.Sprintf("%s:%s", sg.FWId, buildRuleString(rule))
+			log.Printf("[INFO] Destroying security group rule %s", id)
+			if err := testSweepDeleteResource(client, resourceUCloudSecurityGroupRule(), id, nil); err != nil {
+				errs.add(err)
+			}
+		}
+	}
+	return errs.err()
+}
+
+func TestAccUCloudSecurityGroupRule_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_security_group_rule.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckSecurityGroupRuleDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccSecurityGroupRuleConfigInitialRules(rInt),
+				ExpectError: regexp.MustCompile("rules can not be set when rules_management is external"),
+			},
+
+			resource.TestStep{
+				Config: testAccSecurityGroupRuleConfig(rInt),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRuleExists("ucloud_security_group_rule.foo"),
+					testAccCheckSecurityGroupRuleExists("ucloud_security_group_rule.bar"),
+					testAccCheckSecurityGroupRulesCount("ucloud_security_group.foo", 2),
+					resource.TestCheckResourceAttr("ucloud_security_group.foo", "rules_management", "external"),
+					resource.TestCheckResourceAttr("ucloud_security_group_rule.foo", "port_range", "80"),
+					resource.TestCheckResourceAttr("ucloud_security_group_rule.foo", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("ucloud_security_group_rule.foo", "cidr_block", "192.168.0.0/16"),
+					resource.TestCheckResourceAttr("ucloud_security_group_rule.bar", "protocol", "icmp"),
+					resource.TestCheckResourceAttr("ucloud_security_group_rule.bar", "policy", "drop"),
+					resource.TestCheckResourceAttr("ucloud_security_group_rule.bar", "priority", "low"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccSecurityGroupRuleConfigTwo(rInt),
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRuleExists("ucloud_security_group_rule.foo"),
+					testAccCheckSecurityGroupRulesCount("ucloud_security_group.foo", 1),
+					resource.TestCheckResourceAttr("ucloud_security_group_rule.foo", "port_range", "443"),
+					resource.TestCheckResourceAttr("ucloud_security_group_rule.foo", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("ucloud_security_group_rule.foo", "cidr_block", "192.168.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("security group rule id is empty")
+		}
+
+		sgId, rule, err := parseSecurityGroupRuleId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		rules, err := describeSecurityGroupRules(client, sgId)
+
+		log.Printf("[INFO] security group rule id %#v", rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if !isStringIn(buildRuleString(rule), rules) {
+			return fmt.Errorf("security group rule %s is not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// testAccCheckSecurityGroupRulesCount will check the count of rules in security group,
+// the initial rule of security group is removed when the rules are managed by ucloud_security_group_rule.
+func testAccCheckSecurityGroupRulesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		rules, err := describeSecurityGroupRules(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(rules) != count {
+			return fmt.Errorf("expected %d rules of security group %s, got %v", count, rs.Primary.ID, rules)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRuleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_security_group_rule" {
+			continue
+		}
+
+		sgId, rule, err := parseSecurityGroupRuleId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		rules, err := describeSecurityGroupRules(client, sgId)
+
+		// Verify the error is what we want
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if isStringIn(buildRuleString(rule), rules) {
+			return fmt.Errorf("security group rule still exist")
+		}
+	}
+
+	return nil
+}
+
+func testAccSecurityGroupRuleConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "ucloud_security_group" "foo" {
+	name             = "tf-acc-security-group-rule-%d"
+	tag              = "tf-acc"
+	rules_management = "external"
+}
+
+resource "ucloud_security_group_rule" "foo" {
+	security_group_id = "${ucloud_security_group.foo.id}"
+	port_range        = "80"
+	protocol          = "tcp"
+	cidr_block        = "192.168.0.0/16"
+}
+
+resource "ucloud_security_group_rule" "bar" {
+	security_group_id = "${ucloud_security_group.foo.id}"
+	protocol          = "icmp"
+	policy            = "drop"
+	priority          = "low"
+}`, rInt)
+}
+
+func testAccSecurityGroupRuleConfigTwo(rInt int) string {
+	return fmt.Sprintf(`
+resource "ucloud_security_group" "foo" {
+	name             = "tf-acc-security-group-rule-%d"
+	tag              = "tf-acc"
+	rules_management = "external"
+}
+
+resource "ucloud_security_group_rule" "foo" {
+	security_group_id = "${ucloud_security_group.foo.id}"
+	port_range        = "443"
+	protocol          = "tcp"
+	cidr_block        = "192.168.0.0/16"
+}`, rInt)
+}
+
+func testAccSecurityGroupRuleConfigInitialRules(rInt int) string {
+	return fmt.Sprintf(`
+resource "ucloud_security_group" "foo" {
+	name             = "tf-acc-security-group-rule-%d"
+	tag              = "tf-acc"
+	rules_management = "external"
+	rules {
+		port_range = "22"
+		protocol   = "tcp"
+		cidr_block = "192.168.0.0/16"
+	}
+}`, rInt)
+}
+
+func Test_parseSecurityGroupRuleId(t *testing.T) {
+	type args struct {
+		id string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantSgId string
+		wantRule map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			"tcp",
+			args{"firewall-foo:TCP|80|0.0.0.0/0|ACCEPT|HIGH"},
+			"firewall-foo",
+			map[string]interface{}{
+				"protocol":   "tcp",
+				"port_range": "80",
+				"cidr_block": "0.0.0.0/0",
+				"policy":     "accept",
+				"priority":   "high",
+			},
+			false,
+		},
+		{
+			"icmp",
+			args{"firewall-foo:ICMP||10.0.0.0/8|DROP|LOW"},
+			"firewall-foo",
+			map[string]interface{}{
+				"protocol":   "icmp",
+				"port_range": "",
+				"cidr_block": "10.0.0.0/8",
+				"policy":     "drop",
+				"priority":   "low",
+			},
+			false,
+		},
+		{"no security group", args{":TCP|80|0.0.0.0/0|ACCEPT|HIGH"}, "", nil, true},
+		{"no rule", args{"firewall-foo"}, "", nil, true},
+		{"invalid rule", args{"firewall-foo:TCP|80"}, "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sgId, rule, err := parseSecurityGroupRuleId(tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseSecurityGroupRuleId() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if sgId != tt.wantSgId {
+				t.Errorf("parseSecurityGroupRuleId() sgId = %v, want %v", sgId, tt.wantSgId)
+			}
+			if !reflect.DeepEqual(rule, tt.wantRule) {
+				t.Errorf("parseSecurityGroupRuleId() rule = %v, want %v", rule, tt.wantRule)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package ucloud
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -12,7 +13,43 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/unet"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_security_group", &resource.Sweeper{
+		Name: "ucloud_security_group",
+		Dependencies: []string{
+			"ucloud_security_group_rule",
+			"ucloud_security_group_attachment",
+			"ucloud_instance",
+			"ucloud_lb",
+		},
+		F: testSweepSecurityGroups,
+	})
+}
+
+func testSweepSecurityGroups(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sgs, err := testSweepListSecurityGroups(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range sgs {
+		log.Printf("[INFO] Destroying security group %s (%s)", item.FWId, item.Name)
+		if err := testSweepDeleteResource(client, resourceUCloudSecurityGroup(), item.FWId, nil); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudSecurityGroup_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	rInt := acctest.RandInt()
 	var sgSet unet.FirewallDataSet
 
@@ -26,6 +63,11 @@ func TestAccUCloudSecurityGroup_basic(t *testing.T) {
 		CheckDestroy:  testAccCheckSecurityGroupDestroy,
 
 		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccSecurityGroupConfigNoRules(rInt),
+				ExpectError: regexp.MustCompile("rules is required when rules_management is inline"),
+			},
+
 			resource.TestStep{
 				Config: testAccSecurityGroupConfig(rInt),
 
@@ -149,6 +191,14 @@ resource "ucloud_security_group" "foo" {
 }`, rInt)
 }
 
+func testAccSecurityGroupConfigNoRules(rInt int) string {
+	return fmt.Sprintf(`
+resource "ucloud_security_group" "foo" {
+	name = "tf-acc-security-group-%d"
+	tag  = "tf-acc"
+}`, rInt)
+}
+
 func Test_resourceucloudSecurityGroupRuleHash(t *testing.T) {
 	m := map[string]interface{}{
 		"port_range": "80",
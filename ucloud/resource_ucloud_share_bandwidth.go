@@ -0,0 +1,215 @@
+package ucloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func resourceUCloudShareBandwidth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUCloudShareBandwidthCreate,
+		Read:   resourceUCloudShareBandwidthRead,
+		Update: resourceUCloudShareBandwidthUpdate,
+		Delete: resourceUCloudShareBandwidthDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bandwidth": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(20, 5000),
+			},
+
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      resource.PrefixedUniqueId("tf-share-bandwidth-"),
+				ValidateFunc: validateName,
+			},
+
+			"charge_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "month",
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"month",
+					"year",
+					"dynamic",
+				}, false),
+			},
+
+			"duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateDuration,
+			},
+
+			"eip_bandwidth": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 800),
+			},
+
+			"eip_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expire_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUCloudShareBandwidthCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	req := conn.NewAllocateShareBandwidthRequest()
+	req.Name = ucloud.String(d.Get("name").(string))
+	req.ShareBandwidth = ucloud.Int(d.Get("bandwidth").(int))
+	req.ChargeType = ucloud.String(upperCamelCvt.unconvert(d.Get("charge_type").(string)))
+	req.Quantity = ucloud.Int(d.Get("duration").(int))
+
+	resp, err := conn.AllocateShareBandwidth(req)
+	if err != nil {
+		return fmt.Errorf("error on creating share bandwidth, %s", err)
+	}
+
+	d.SetId(resp.ShareBandwidthId)
+
+	// after create share bandwidth, we need to wait it initialized
+	stateConf := shareBandwidthWaitForState(client, d.Id())
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for share bandwidth %s complete creating, %s", d.Id(), err)
+	}
+
+	return resourceUCloudShareBandwidthRead(d, meta)
+}
+
+func resourceUCloudShareBandwidthUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	d.Partial(true)
+
+	if d.HasChange("bandwidth") && !d.IsNewResource() {
+		req := conn.NewResizeShareBandwidthRequest()
+		req.ShareBandwidthId = ucloud.String(d.Id())
+		req.ShareBandwidth = ucloud.Int(d.Get("bandwidth").(int))
+
+		_, err := conn.ResizeShareBandwidth(req)
+		if err != nil {
+			return fmt.Errorf("error on %s to share bandwidth %s, %s", "ResizeShareBandwidth", d.Id(), err)
+		}
+
+		d.SetPartial("bandwidth")
+	}
+
+	// eip bandwidth is only used when share bandwidth is released
+	d.SetPartial("eip_bandwidth")
+
+	d.Partial(false)
+
+	return resourceUCloudShareBandwidthRead(d, meta)
+}
+
+func resourceUCloudShareBandwidthRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	shareBandwidthSet, err := client.describeShareBandwidthById(d.Id())
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading share bandwidth %s, %s", d.Id(), err)
+	}
+
+	d.Set("name", shareBandwidthSet.Name)
+	d.Set("bandwidth", shareBandwidthSet.ShareBandwidth)
+	d.Set("charge_type", upperCamelCvt.convert(shareBandwidthSet.ChargeType))
+	d.Set("create_time", timestampToString(shareBandwidthSet.CreateTime))
+	d.Set("expire_time", timestampToString(shareBandwidthSet.ExpireTime))
+
+	eipIds := []string{}
+	for _, item := range shareBandwidthSet.EIPSet {
+		eipIds = append(eipIds, item.EIPId)
+	}
+
+	if err := d.Set("eip_ids", eipIds); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceUCloudShareBandwidthDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	req := conn.NewReleaseShareBandwidthRequest()
+	req.ShareBandwidthId = ucloud.String(d.Id())
+	req.EIPBandwidth = ucloud.Int(d.Get("eip_bandwidth").(int))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if _, err := conn.ReleaseShareBandwidth(req); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error on deleting share bandwidth %s, %s", d.Id(), err))
+		}
+
+		_, err := client.describeShareBandwidthById(d.Id())
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("error on reading share bandwidth when deleting %s, %s", d.Id(), err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("the specified share bandwidth %s has not been deleted due to unknown error", d.Id()))
+	})
+}
+
+func shareBandwidthWaitForState(client *UCloudClient, shareBandwidthId string) *resource.StateChangeConf {
+	return &resource.StateChangeConf{
+		Pending:    []string{statusPending},
+		Target:     []string{statusInitialized},
+		Timeout:    5 * time.Minute,
+		Delay:      2 * time.Second,
+		MinTimeout: 1 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			shareBandwidthSet, err := client.describeShareBandwidthById(shareBandwidthId)
+			if err != nil {
+				if isNotFoundError(err) {
+					return nil, statusPending, nil
+				}
+				return nil, "", err
+			}
+
+			return shareBandwidthSet, statusInitialized, nil
+		},
+	}
+}
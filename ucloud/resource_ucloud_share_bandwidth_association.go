@@ -0,0 +1,160 @@
+package ucloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func resourceUCloudShareBandwidthAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUCloudShareBandwidthAssociationCreate,
+		Read:   resourceUCloudShareBandwidthAssociationRead,
+		Delete: resourceUCloudShareBandwidthAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"share_bandwidth_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"eip_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"eip_bandwidth": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 800),
+			},
+
+			"eip_charge_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "bandwidth",
+				ValidateFunc: validation.StringInSlice([]string{
+					"traffic",
+					"bandwidth",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceUCloudShareBandwidthAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	shareBandwidthId := d.Get("share_bandwidth_id").(string)
+	eipId := d.Get("eip_id").(string)
+
+	// the membership of share bandwidth is serialized to avoid conflict
+	ucloudMutexKV.Lock(shareBandwidthId)
+	defer ucloudMutexKV.Unlock(shareBandwidthId)
+
+	req := conn.NewAssociateEIPWithShareBandwidthRequest()
+	req.ShareBandwidthId = ucloud.String(shareBandwidthId)
+	req.EIPIds = []string{eipId}
+
+	_, err := conn.AssociateEIPWithShareBandwidth(req)
+	if err != nil {
+		return fmt.Errorf("error on creating share bandwidth association, %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("share_bandwidth#%s:eip#%s", shareBandwidthId, eipId))
+
+	// after associate eip with share bandwidth we need to wait it completed
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{statusPending},
+		Target:     []string{statusInitialized},
+		Timeout:    5 * time.Minute,
+		Delay:      2 * time.Second,
+		MinTimeout: 1 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			eip, err := client.describeShareBandwidthEIPById(shareBandwidthId, eipId)
+			if err != nil {
+				if isNotFoundError(err) {
+					return nil, statusPending, nil
+				}
+				return nil, "", err
+			}
+
+			return eip, statusInitialized, nil
+		},
+	}
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for share bandwidth association is completed when creating %s, %s", d.Id(), err)
+	}
+
+	return resourceUCloudShareBandwidthAssociationRead(d, meta)
+}
+
+func resourceUCloudShareBandwidthAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	assoc, err := parseAssociationInfo(d.Id())
+	if err != nil {
+		return fmt.Errorf("error on parsing share bandwidth association %s, %s", d.Id(), err)
+	}
+
+	eip, err := client.describeShareBandwidthEIPById(assoc.PrimaryId, assoc.ResourceId)
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading share bandwidth association %s, %s", d.Id(), err)
+	}
+
+	d.Set("share_bandwidth_id", assoc.PrimaryId)
+	d.Set("eip_id", eip.EIPId)
+
+	return nil
+}
+
+func resourceUCloudShareBandwidthAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	assoc, err := parseAssociationInfo(d.Id())
+	if err != nil {
+		return fmt.Errorf("error on parsing share bandwidth association %s, %s", d.Id(), err)
+	}
+
+	ucloudMutexKV.Lock(assoc.PrimaryId)
+	defer ucloudMutexKV.Unlock(assoc.PrimaryId)
+
+	req := conn.NewDisassociateEIPWithShareBandwidthRequest()
+	req.ShareBandwidthId = ucloud.String(assoc.PrimaryId)
+	req.EIPIds = []string{assoc.ResourceId}
+	req.Bandwidth = ucloud.Int(d.Get("eip_bandwidth").(int))
+	req.PayMode = ucloud.String(upperCamelCvt.unconvert(d.Get("eip_charge_mode").(string)))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if _, err := conn.DisassociateEIPWithShareBandwidth(req); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error on deleting share bandwidth association %s, %s", d.Id(), err))
+		}
+
+		_, err := client.describeShareBandwidthEIPById(assoc.PrimaryId, assoc.ResourceId)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil
+			}
+
+			return resource.NonRetryableError(fmt.Errorf("error on reading share bandwidth association when deleting %s, %s", d.Id(), err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("the specified share bandwidth association %s has not been deleted due to unknown error", d.Id()))
+	})
+}
@@ -0,0 +1,144 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
+)
+
+func init() {
+	resource.AddTestSweepers("ucloud_share_bandwidth_association", &resource.Sweeper{
+		Name: "ucloud_share_bandwidth_association",
+		F:    testSweepShareBandwidthAssociations,
+	})
+}
+
+func testSweepShareBandwidthAssociations(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	shareBandwidths, err := testSweepListShareBandwidths(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range shareBandwidths {
+		for _, eip := range item.EIPSet {
+			id := fmt.Sprintf("share_bandwidth#%s:eip#%s", item.ShareBandwidthId, eip.EIPId)
+			log.Printf("[INFO] Destroying share bandwidth association %s", id)
+			attributes := map[string]interface{}{
+				"eip_bandwidth":   1,
+				"eip_charge_mode": "bandwidth",
+			}
+			if err := testSweepDeleteResource(client, resourceUCloudShareBandwidthAssociation(), id, attributes); err != nil {
+				errs.add(err)
+			}
+		}
+	}
+	return errs.err()
+}
+
+func TestAccUCloudShareBandwidthAssociation_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	var shareBandwidth unet.UnetShareBandwidthSet
+	var eip unet.UnetEIPSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_share_bandwidth_association.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckShareBandwidthAssociationDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccShareBandwidthAssociationConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShareBandwidthExists("ucloud_share_bandwidth.foo", &shareBandwidth),
+					testAccCheckEIPExists("ucloud_eip.foo", &eip),
+					testAccCheckShareBandwidthAssociationExists("ucloud_share_bandwidth_association.foo", &shareBandwidth, &eip),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckShareBandwidthAssociationExists(n string, shareBandwidth *unet.UnetShareBandwidthSet, eip *unet.UnetEIPSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("share bandwidth association id is empty")
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		d, err := client.describeShareBandwidthEIPById(shareBandwidth.ShareBandwidthId, eip.EIPId)
+		if err != nil {
+			return err
+		}
+
+		if d.EIPId != eip.EIPId {
+			return fmt.Errorf("share bandwidth association not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckShareBandwidthAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_share_bandwidth_association" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		_, err := client.describeShareBandwidthEIPById(rs.Primary.Attributes["share_bandwidth_id"], rs.Primary.Attributes["eip_id"])
+
+		// Verify the error is what we want
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("share bandwidth association still exists")
+	}
+
+	return nil
+}
+
+const testAccShareBandwidthAssociationConfig = `
+resource "ucloud_share_bandwidth" "foo" {
+	name        = "tf-acc-share-bandwidth-association"
+	bandwidth   = 20
+	charge_type = "dynamic"
+}
+
+resource "ucloud_eip" "foo" {
+	name          = "tf-acc-share-bandwidth-association-eip"
+	internet_type = "bgp"
+	charge_type   = "dynamic"
+	bandwidth     = 1
+}
+
+resource "ucloud_share_bandwidth_association" "foo" {
+	share_bandwidth_id = "${ucloud_share_bandwidth.foo.id}"
+	eip_id             = "${ucloud_eip.foo.id}"
+}
+`
@@ -0,0 +1,168 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
+)
+
+func init() {
+	resource.AddTestSweepers("ucloud_share_bandwidth", &resource.Sweeper{
+		Name: "ucloud_share_bandwidth",
+		Dependencies: []string{
+			"ucloud_share_bandwidth_association",
+		},
+		F: testSweepShareBandwidths,
+	})
+}
+
+func testSweepShareBandwidths(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	shareBandwidths, err := testSweepListShareBandwidths(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range shareBandwidths {
+		log.Printf("[INFO] Destroying share bandwidth %s (%s)", item.ShareBandwidthId, item.Name)
+		attributes := map[string]interface{}{"eip_bandwidth": 1}
+		if err := testSweepDeleteResource(client, resourceUCloudShareBandwidth(), item.ShareBandwidthId, attributes); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
+func TestAccUCloudShareBandwidth_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	var shareBandwidth unet.UnetShareBandwidthSet
+	var eip unet.UnetEIPSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_share_bandwidth.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckShareBandwidthDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccShareBandwidthConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShareBandwidthExists("ucloud_share_bandwidth.foo", &shareBandwidth),
+					testAccCheckEIPExists("ucloud_eip.foo", &eip),
+					resource.TestCheckResourceAttr("ucloud_share_bandwidth.foo", "bandwidth", "20"),
+					resource.TestCheckResourceAttr("ucloud_share_bandwidth.foo", "name", "tf-acc-share-bandwidth"),
+					resource.TestCheckResourceAttr("ucloud_share_bandwidth.foo", "charge_type", "dynamic"),
+					resource.TestCheckResourceAttrPair("ucloud_eip.foo", "share_bandwidth_id", "ucloud_share_bandwidth.foo", "id"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccShareBandwidthConfigTwo,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShareBandwidthExists("ucloud_share_bandwidth.foo", &shareBandwidth),
+					resource.TestCheckResourceAttr("ucloud_share_bandwidth.foo", "bandwidth", "30"),
+					resource.TestCheckResourceAttr("ucloud_share_bandwidth.foo", "eip_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("ucloud_eip.foo", "share_bandwidth_id", "ucloud_share_bandwidth.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckShareBandwidthExists(n string, shareBandwidth *unet.UnetShareBandwidthSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("share bandwidth id is empty")
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		ptr, err := client.describeShareBandwidthById(rs.Primary.ID)
+
+		log.Printf("[INFO] share bandwidth id %#v", rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*shareBandwidth = *ptr
+		return nil
+	}
+}
+
+func testAccCheckShareBandwidthDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_share_bandwidth" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		d, err := client.describeShareBandwidthById(rs.Primary.ID)
+
+		// Verify the error is what we want
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if d.ShareBandwidthId != "" {
+			return fmt.Errorf("share bandwidth still exist")
+		}
+	}
+
+	return nil
+}
+
+const testAccShareBandwidthConfig = `
+resource "ucloud_share_bandwidth" "foo" {
+	name        = "tf-acc-share-bandwidth"
+	bandwidth   = 20
+	charge_type = "dynamic"
+}
+
+resource "ucloud_eip" "foo" {
+	name               = "tf-acc-share-bandwidth-eip"
+	internet_type      = "bgp"
+	charge_type        = "dynamic"
+	share_bandwidth_id = "${ucloud_share_bandwidth.foo.id}"
+}
+`
+
+const testAccShareBandwidthConfigTwo = `
+resource "ucloud_share_bandwidth" "foo" {
+	name        = "tf-acc-share-bandwidth"
+	bandwidth   = 30
+	charge_type = "dynamic"
+}
+
+resource "ucloud_eip" "foo" {
+	name               = "tf-acc-share-bandwidth-eip"
+	internet_type      = "bgp"
+	charge_type        = "dynamic"
+	bandwidth          = 5
+	charge_mode        = "traffic"
+	share_bandwidth_id = "${ucloud_share_bandwidth.foo.id}"
+}
+`
@@ -8,9 +8,64 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/ucloud/ucloud-sdk-go/services/vpc"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_subnet", &resource.Sweeper{
+		Name: "ucloud_subnet",
+		Dependencies: []string{
+			"ucloud_instance",
+			"ucloud_lb",
+			"ucloud_vip",
+		},
+		F: testSweepSubnets,
+	})
+}
+
+func testSweepSubnets(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.vpcconn
+	req := conn.NewDescribeSubnetRequest()
+
+	var subnets []vpc.VPCSubnetInfoSet
+	limit := 100
+	for offset := 0; ; offset += limit {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeSubnet(req)
+		if err != nil {
+			return fmt.Errorf("error on reading subnet list, %s", err)
+		}
+
+		for _, item := range resp.DataSet {
+			if isSweepableName(item.SubnetName) {
+				subnets = append(subnets, item)
+			}
+		}
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+	}
+
+	var errs testSweepErrors
+	for _, item := range subnets {
+		log.Printf("[INFO] Destroying subnet %s (%s)", item.SubnetId, item.SubnetName)
+		if err := testSweepDeleteResource(client, resourceUCloudSubnet(), item.SubnetId, nil); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudSubnet_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var val vpc.VPCSubnetInfoSet
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -0,0 +1,147 @@
+package ucloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+func resourceUCloudVIP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUCloudVIPCreate,
+		Read:   resourceUCloudVIPRead,
+		Delete: resourceUCloudVIPDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      resource.PrefixedUniqueId("tf-vip-"),
+				ValidateFunc: validateName,
+			},
+
+			"ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUCloudVIPCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	req := conn.NewAllocateVIPRequest()
+	req.VPCId = ucloud.String(d.Get("vpc_id").(string))
+	req.SubnetId = ucloud.String(d.Get("subnet_id").(string))
+	req.Name = ucloud.String(d.Get("name").(string))
+	req.Count = ucloud.Int(1)
+
+	resp, err := conn.AllocateVIP(req)
+	if err != nil {
+		return fmt.Errorf("error on creating vip, %s", err)
+	}
+
+	if len(resp.VIPSet) != 1 {
+		return fmt.Errorf("error on creating vip, expected exactly one vip, got %v", len(resp.VIPSet))
+	}
+
+	d.SetId(resp.VIPSet[0].VIPId)
+
+	// after create vip, we need to wait it initialized
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{statusPending},
+		Target:     []string{statusInitialized},
+		Timeout:    5 * time.Minute,
+		Delay:      2 * time.Second,
+		MinTimeout: 1 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			vip, err := client.describeVIPById(d.Id(), d.Get("vpc_id").(string), d.Get("subnet_id").(string))
+			if err != nil {
+				if isNotFoundError(err) {
+					return nil, statusPending, nil
+				}
+				return nil, "", err
+			}
+
+			return vip, statusInitialized, nil
+		},
+	}
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error on waiting for vip %s complete creating, %s", d.Id(), err)
+	}
+
+	return resourceUCloudVIPRead(d, meta)
+}
+
+func resourceUCloudVIPRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+
+	vip, err := client.describeVIPById(d.Id(), d.Get("vpc_id").(string), d.Get("subnet_id").(string))
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error on reading vip %s, %s", d.Id(), err)
+	}
+
+	d.Set("vpc_id", vip.VPCId)
+	d.Set("subnet_id", vip.SubnetId)
+	d.Set("name", vip.Name)
+	d.Set("ip", vip.VIP)
+	d.Set("create_time", timestampToString(vip.CreateTime))
+
+	return nil
+}
+
+func resourceUCloudVIPDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*UCloudClient)
+	conn := client.unetconn
+
+	req := conn.NewReleaseVIPRequest()
+	req.VIPId = ucloud.String(d.Id())
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if _, err := conn.ReleaseVIP(req); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error on deleting vip %s, %s", d.Id(), err))
+		}
+
+		_, err := client.describeVIPById(d.Id(), d.Get("vpc_id").(string), d.Get("subnet_id").(string))
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("error on reading vip when deleting %s, %s", d.Id(), err))
+		}
+
+		return resource.RetryableError(fmt.Errorf("the specified vip %s has not been deleted due to unknown error", d.Id()))
+	})
+}
@@ -0,0 +1,148 @@
+package ucloud
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
+)
+
+func init() {
+	resource.AddTestSweepers("ucloud_vip", &resource.Sweeper{
+		Name: "ucloud_vip",
+		F:    testSweepVIPs,
+	})
+}
+
+func testSweepVIPs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	conn := client.unetconn
+	resp, err := conn.DescribeVIP(conn.NewDescribeVIPRequest())
+	if err != nil {
+		return fmt.Errorf("error on reading vip list, %s", err)
+	}
+
+	var errs testSweepErrors
+	for _, item := range resp.VIPSet {
+		if !isSweepableName(item.Name) {
+			continue
+		}
+
+		log.Printf("[INFO] Destroying vip %s (%s)", item.VIPId, item.Name)
+		attributes := map[string]interface{}{
+			"vpc_id":    item.VPCId,
+			"subnet_id": item.SubnetId,
+		}
+		if err := testSweepDeleteResource(client, resourceUCloudVIP(), item.VIPId, attributes); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
+func TestAccUCloudVIP_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
+	var vip unet.VIPDetailSet
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		IDRefreshName: "ucloud_vip.foo",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVIPDestroy,
+
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVIPConfig,
+
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVIPExists("ucloud_vip.foo", &vip),
+					resource.TestCheckResourceAttr("ucloud_vip.foo", "name", "tf-acc-vip"),
+					resource.TestCheckResourceAttrSet("ucloud_vip.foo", "ip"),
+					resource.TestCheckResourceAttrPair("ucloud_vip.foo", "subnet_id", "ucloud_subnet.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVIPExists(n string, vip *unet.VIPDetailSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("vip id is empty")
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		ptr, err := client.describeVIPById(rs.Primary.ID, rs.Primary.Attributes["vpc_id"], rs.Primary.Attributes["subnet_id"])
+
+		log.Printf("[INFO] vip id %#v", rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*vip = *ptr
+		return nil
+	}
+}
+
+func testAccCheckVIPDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ucloud_vip" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*UCloudClient)
+		d, err := client.describeVIPById(rs.Primary.ID, rs.Primary.Attributes["vpc_id"], rs.Primary.Attributes["subnet_id"])
+
+		// Verify the error is what we want
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		if d.VIPId != "" {
+			return fmt.Errorf("vip still exist")
+		}
+	}
+
+	return nil
+}
+
+const testAccVIPConfig = `
+resource "ucloud_vpc" "foo" {
+	name        = "tf-acc-vip"
+	tag         = "tf-acc"
+	cidr_blocks = ["192.168.0.0/16"]
+}
+
+resource "ucloud_subnet" "foo" {
+	name       = "tf-acc-vip"
+	tag        = "tf-acc"
+	cidr_block = "192.168.1.0/24"
+	vpc_id     = "${ucloud_vpc.foo.id}"
+}
+
+resource "ucloud_vip" "foo" {
+	vpc_id    = "${ucloud_vpc.foo.id}"
+	subnet_id = "${ucloud_subnet.foo.id}"
+	name      = "tf-acc-vip"
+}
+`
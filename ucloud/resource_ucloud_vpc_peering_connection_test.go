@@ -8,9 +8,57 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/ucloud/ucloud-sdk-go/services/vpc"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_vpc_peering_connection", &resource.Sweeper{
+		Name: "ucloud_vpc_peering_connection",
+		F:    testSweepVPCPeeringConnections,
+	})
+}
+
+func testSweepVPCPeeringConnections(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	vpcs, err := testSweepListVPCs(client)
+	if err != nil {
+		return err
+	}
+
+	conn := client.vpcconn
+	var errs testSweepErrors
+	for _, item := range vpcs {
+		req := conn.NewDescribeVPCIntercomRequest()
+		req.VPCId = ucloud.String(item.VPCId)
+
+		resp, err := conn.DescribeVPCIntercom(req)
+		if err != nil {
+			errs.add(fmt.Errorf("error on reading vpc peering connection list of %s, %s", item.VPCId, err))
+			continue
+		}
+
+		for _, peer := range resp.DataSet {
+			id := fmt.Sprintf(
+				"%s@%s#%s:%s@%s#%s",
+				client.region, client.projectId, item.VPCId,
+				peer.DstRegion, peer.ProjectId, peer.VPCId,
+			)
+			log.Printf("[INFO] Destroying vpc peering connection %s", id)
+			if err := testSweepDeleteResource(client, resourceUCloudVPCPeeringConnection(), id, nil); err != nil {
+				errs.add(err)
+			}
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudVPCPeeringConnection_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var vpc1 vpc.VPCInfo
 	var vpc2 vpc.VPCInfo
 	var val vpc.VPCIntercomInfo
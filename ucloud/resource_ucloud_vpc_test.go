@@ -10,7 +10,41 @@ import (
 	"github.com/ucloud/ucloud-sdk-go/services/vpc"
 )
 
+func init() {
+	resource.AddTestSweepers("ucloud_vpc", &resource.Sweeper{
+		Name: "ucloud_vpc",
+		Dependencies: []string{
+			"ucloud_subnet",
+			"ucloud_vpc_peering_connection",
+		},
+		F: testSweepVPCs,
+	})
+}
+
+func testSweepVPCs(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	vpcs, err := testSweepListVPCs(client)
+	if err != nil {
+		return err
+	}
+
+	var errs testSweepErrors
+	for _, item := range vpcs {
+		log.Printf("[INFO] Destroying vpc %s (%s)", item.VPCId, item.Name)
+		if err := testSweepDeleteResource(client, resourceUCloudVPC(), item.VPCId, nil); err != nil {
+			errs.add(err)
+		}
+	}
+	return errs.err()
+}
+
 func TestAccUCloudVPC_basic(t *testing.T) {
+	defer useCassetteIfEnabled(t)()
+
 	var val vpc.VPCInfo
 
 	resource.ParallelTest(t, resource.TestCase{
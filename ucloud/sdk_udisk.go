@@ -0,0 +1,158 @@
+package ucloud
+
+import (
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+	"github.com/ucloud/ucloud-sdk-go/ucloud/auth"
+	"github.com/ucloud/ucloud-sdk-go/ucloud/request"
+	"github.com/ucloud/ucloud-sdk-go/ucloud/response"
+)
+
+// udiskExtClient is the client of ucloud disk for the actions
+// which are not supported by the vendored sdk yet, such as snapshot describing and deleting.
+// It should be removed after these actions are generated into the sdk.
+type udiskExtClient struct {
+	client *ucloud.Client
+}
+
+func newUDiskExtClient(config *ucloud.Config, credential *auth.Credential) *udiskExtClient {
+	return &udiskExtClient{
+		client: ucloud.NewClient(config, credential),
+	}
+}
+
+// uDiskSnapshotSet is the snapshot model of DescribeUDiskSnapshot action
+type uDiskSnapshotSet struct {
+	// 快照Id
+	SnapshotId string
+
+	// 快照名称
+	Name string
+
+	// 快照的源UDisk的Id
+	UDiskId string
+
+	// 快照的源UDisk的Name
+	UDiskName string
+
+	// 创建时间
+	CreateTime int
+
+	// 过期时间
+	ExpiredTime int
+
+	// 容量单位GB
+	Size int
+
+	// 快照描述
+	Comment string
+
+	// 快照状态，Normal:正常,Failed:失败,Creating:制作中
+	Status string
+
+	// 磁盘类型，0:数据盘，1:系统盘
+	DiskType int
+
+	// 对应磁盘是否处于可用状态
+	UDiskStatus string
+
+	// 快照是否过期，过期:"Yes", 未过期:"No"
+	IsExpire string
+
+	// 快照计费方式，Year,Month,Dynamic,Trial
+	ChargeType string
+}
+
+// describeUDiskSnapshotRequest is request schema for DescribeUDiskSnapshot action
+type describeUDiskSnapshotRequest struct {
+	request.CommonBase
+
+	// 可用区。参见 [可用区列表](../summary/regionlist.html)
+	Zone *string `required:"false"`
+
+	// UDiskId,返回该盘所做快照.(必须同时传Zone)
+	UDiskId *string `required:"false"`
+
+	// 快照id，SnapshotId , UDiskId 同时传SnapshotId优先
+	SnapshotId *string `required:"false"`
+
+	// 数据偏移量, 默认为0
+	Offset *int `required:"false"`
+
+	// 返回数据长度, 默认为20
+	Limit *int `required:"false"`
+}
+
+// describeUDiskSnapshotResponse is response schema for DescribeUDiskSnapshot action
+type describeUDiskSnapshotResponse struct {
+	response.CommonBase
+
+	// JSON 格式的Snapshot列表
+	DataSet []uDiskSnapshotSet
+
+	// 根据过滤条件得到的总数
+	TotalCount int
+}
+
+// NewDescribeUDiskSnapshotRequest will create request of DescribeUDiskSnapshot action.
+func (c *udiskExtClient) NewDescribeUDiskSnapshotRequest() *describeUDiskSnapshotRequest {
+	req := &describeUDiskSnapshotRequest{}
+
+	// setup request with client config
+	c.client.SetupRequest(req)
+
+	// setup retryable with default retry policy (retry for non-create action and common error)
+	req.SetRetryable(true)
+	return req
+}
+
+// DescribeUDiskSnapshot - 获取UDisk快照
+func (c *udiskExtClient) DescribeUDiskSnapshot(req *describeUDiskSnapshotRequest) (*describeUDiskSnapshotResponse, error) {
+	var res describeUDiskSnapshotResponse
+
+	err := c.client.InvokeAction("DescribeUDiskSnapshot", req, &res)
+	if err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
+
+// deleteUDiskSnapshotRequest is request schema for DeleteUDiskSnapshot action
+type deleteUDiskSnapshotRequest struct {
+	request.CommonBase
+
+	// 可用区。参见 [可用区列表](../summary/regionlist.html)
+	Zone *string `required:"true"`
+
+	// 快照Id(填写后不能填写UDisk Id)
+	SnapshotId *string `required:"false"`
+}
+
+// deleteUDiskSnapshotResponse is response schema for DeleteUDiskSnapshot action
+type deleteUDiskSnapshotResponse struct {
+	response.CommonBase
+}
+
+// NewDeleteUDiskSnapshotRequest will create request of DeleteUDiskSnapshot action.
+func (c *udiskExtClient) NewDeleteUDiskSnapshotRequest() *deleteUDiskSnapshotRequest {
+	req := &deleteUDiskSnapshotRequest{}
+
+	// setup request with client config
+	c.client.SetupRequest(req)
+
+	// setup retryable with default retry policy (retry for non-create action and common error)
+	req.SetRetryable(true)
+	return req
+}
+
+// DeleteUDiskSnapshot - 删除快照
+func (c *udiskExtClient) DeleteUDiskSnapshot(req *deleteUDiskSnapshotRequest) (*deleteUDiskSnapshotResponse, error) {
+	var res deleteUDiskSnapshotResponse
+
+	err := c.client.InvokeAction("DeleteUDiskSnapshot", req, &res)
+	if err != nil {
+		return &res, err
+	}
+
+	return &res, nil
+}
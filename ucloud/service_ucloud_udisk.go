@@ -43,3 +43,22 @@ func (client *UCloudClient) describeDiskResource(diskId, resourceId string) (*ud
 
 	return nil, newNotFoundError(getNotFoundMessage("disk_attachment", diskId))
 }
+
+func (client *UCloudClient) describeDiskSnapshotById(zone, snapshotId string) (*uDiskSnapshotSet, error) {
+	req := client.udiskextconn.NewDescribeUDiskSnapshotRequest()
+	req.SnapshotId = ucloud.String(snapshotId)
+	if zone != "" {
+		req.Zone = ucloud.String(zone)
+	}
+
+	resp, err := client.udiskextconn.DescribeUDiskSnapshot(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.DataSet) < 1 {
+		return nil, newNotFoundError(getNotFoundMessage("disk snapshot", snapshotId))
+	}
+
+	return &resp.DataSet[0], nil
+}
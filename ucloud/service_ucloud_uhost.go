@@ -34,3 +34,20 @@ func (client *UCloudClient) DescribeImageById(imageId string) (*uhost.UHostImage
 
 	return &resp.ImageSet[0], nil
 }
+
+func (client *UCloudClient) describeImageByRegion(imageId, region, projectId string) (*uhost.UHostImageSet, error) {
+	req := client.uhostconn.NewDescribeImageRequest()
+	req.ImageId = ucloud.String(imageId)
+	req.SetRegion(region)
+	req.SetProjectId(projectId)
+
+	resp, err := client.uhostconn.DescribeImage(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.ImageSet) < 1 {
+		return nil, newNotFoundError(getNotFoundMessage("image", imageId))
+	}
+
+	return &resp.ImageSet[0], nil
+}
@@ -1,6 +1,8 @@
 package ucloud
 
 import (
+	"strconv"
+
 	"github.com/ucloud/ucloud-sdk-go/services/unet"
 	"github.com/ucloud/ucloud-sdk-go/ucloud"
 	uerr "github.com/ucloud/ucloud-sdk-go/ucloud/error"
@@ -72,3 +74,219 @@ func (c *UCloudClient) describeFirewallById(sgId string) (*unet.FirewallDataSet,
 
 	return &resp.DataSet[0], nil
 }
+
+// describeFirewallByResource will returns the security group attached to the resource
+func (c *UCloudClient) describeFirewallByResource(resourceType, resourceId string) (*unet.FirewallDataSet, error) {
+	conn := c.unetconn
+
+	req := conn.NewDescribeFirewallRequest()
+	req.ResourceType = ucloud.String(resourceType)
+	req.ResourceId = ucloud.String(resourceId)
+
+	resp, err := conn.DescribeFirewall(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || len(resp.DataSet) < 1 {
+		return nil, newNotFoundError(getNotFoundMessage("security group of resource", resourceId))
+	}
+
+	return &resp.DataSet[0], nil
+}
+
+// describeDefaultFirewall will returns the default security group of project which is not recommended for web server,
+// it only allows the remote access such as ssh, rdp and ping.
+func (c *UCloudClient) describeDefaultFirewall() (*unet.FirewallDataSet, error) {
+	conn := c.unetconn
+
+	req := conn.NewDescribeFirewallRequest()
+
+	limit := 100
+	offset := 0
+	for {
+		req.Limit = ucloud.String(strconv.Itoa(limit))
+		req.Offset = ucloud.String(strconv.Itoa(offset))
+
+		resp, err := conn.DescribeFirewall(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp == nil || len(resp.DataSet) < 1 {
+			break
+		}
+
+		for i := 0; i < len(resp.DataSet); i++ {
+			if resp.DataSet[i].Type == "recommend non web" {
+				return &resp.DataSet[i], nil
+			}
+		}
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	return nil, newNotFoundError(getNotFoundMessage("default security group", "recommend non web"))
+}
+
+func (c *UCloudClient) describeFirewallResourcesById(sgId string) ([]unet.ResourceSet, error) {
+	conn := c.unetconn
+
+	req := conn.NewDescribeFirewallResourceRequest()
+	req.FWId = ucloud.String(sgId)
+
+	var resources []unet.ResourceSet
+	limit := 100
+	offset := 0
+	for {
+		req.Limit = ucloud.String(strconv.Itoa(limit))
+		req.Offset = ucloud.String(strconv.Itoa(offset))
+
+		resp, err := conn.DescribeFirewallResource(req)
+		if err != nil {
+			if uErr, ok := err.(uerr.Error); ok && uErr.Code() == 54002 {
+				return nil, newNotFoundError(getNotFoundMessage("security group", sgId))
+			}
+			return nil, err
+		}
+
+		if resp == nil || len(resp.ResourceSet) < 1 {
+			break
+		}
+
+		resources = append(resources, resp.ResourceSet...)
+
+		if len(resp.ResourceSet) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	return resources, nil
+}
+
+func (c *UCloudClient) describeSecurityGroupAttachmentById(sgId, resourceId string) (*unet.ResourceSet, error) {
+	resources, err := c.describeFirewallResourcesById(sgId)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, newNotFoundError(getNotFoundMessage("security group attachment", resourceId))
+		}
+		return nil, err
+	}
+
+	for i := 0; i < len(resources); i++ {
+		if resources[i].ResourceID == resourceId {
+			return &resources[i], nil
+		}
+	}
+
+	return nil, newNotFoundError(getNotFoundMessage("security group attachment", resourceId))
+}
+
+func (c *UCloudClient) describeShareBandwidthById(shareBandwidthId string) (*unet.UnetShareBandwidthSet, error) {
+	conn := c.unetconn
+
+	req := conn.NewDescribeShareBandwidthRequest()
+	req.ShareBandwidthIds = []string{shareBandwidthId}
+
+	resp, err := conn.DescribeShareBandwidth(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || len(resp.DataSet) < 1 {
+		return nil, newNotFoundError(getNotFoundMessage("share bandwidth", shareBandwidthId))
+	}
+
+	return &resp.DataSet[0], nil
+}
+
+func (c *UCloudClient) describeShareBandwidthEIPById(shareBandwidthId, eipId string) (*unet.EIPSetData, error) {
+	shareBandwidthSet, err := c.describeShareBandwidthById(shareBandwidthId)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, newNotFoundError(getNotFoundMessage("share bandwidth association", eipId))
+		}
+		return nil, err
+	}
+
+	for i := 0; i < len(shareBandwidthSet.EIPSet); i++ {
+		eip := shareBandwidthSet.EIPSet[i]
+		if eip.EIPId == eipId {
+			return &eip, nil
+		}
+	}
+
+	return nil, newNotFoundError(getNotFoundMessage("share bandwidth association", eipId))
+}
+
+func (c *UCloudClient) describeBandwidthPackageById(packageId string) (*unet.UnetBandwidthPackageSet, error) {
+	conn := c.unetconn
+
+	limit := 100
+	offset := 0
+	for {
+		req := conn.NewDescribeBandwidthPackageRequest()
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+
+		resp, err := conn.DescribeBandwidthPackage(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp == nil || len(resp.DataSets) < 1 {
+			break
+		}
+
+		for i := 0; i < len(resp.DataSets); i++ {
+			item := resp.DataSets[i]
+			if item.BandwidthPackageId == packageId {
+				return &item, nil
+			}
+		}
+
+		if len(resp.DataSets) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	return nil, newNotFoundError(getNotFoundMessage("eip bandwidth package", packageId))
+}
+
+// describeVIPById will find the vip in the vpc and subnet, the vip is only found in the default tag
+// if the vpc and subnet are unknown, such as the vip is imported.
+func (c *UCloudClient) describeVIPById(vipId, vpcId, subnetId string) (*unet.VIPDetailSet, error) {
+	conn := c.unetconn
+
+	req := conn.NewDescribeVIPRequest()
+	if vpcId != "" && subnetId != "" {
+		req.VPCId = ucloud.String(vpcId)
+		req.SubnetId = ucloud.String(subnetId)
+	}
+
+	resp, err := conn.DescribeVIP(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil {
+		return nil, newNotFoundError(getNotFoundMessage("vip", vipId))
+	}
+
+	for i := 0; i < len(resp.VIPSet); i++ {
+		vip := resp.VIPSet[i]
+		if vip.VIPId == vipId {
+			return &vip, nil
+		}
+	}
+
+	return nil, newNotFoundError(getNotFoundMessage("vip", vipId))
+}
@@ -66,3 +66,44 @@ func (c *UCloudClient) describeVPCIntercomById(vpcId, peerVPCId, peerRegion, pee
 
 	return nil, newNotFoundError(getNotFoundMessage("vpc peer connection", vpcId))
 }
+
+// describeSubnetUsedIPs will returns the distinct IP addresses used by the resources in subnet
+func (c *UCloudClient) describeSubnetUsedIPs(subnetId string) ([]string, error) {
+	conn := c.vpcconn
+
+	req := conn.NewDescribeSubnetResourceRequest()
+	req.SubnetId = ucloud.String(subnetId)
+
+	ips := []string{}
+	seen := map[string]bool{}
+	limit := 100
+	offset := 0
+	for {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+
+		resp, err := conn.DescribeSubnetResource(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp == nil || len(resp.DataSet) < 1 {
+			break
+		}
+
+		for _, item := range resp.DataSet {
+			if item.IP != "" && !seen[item.IP] {
+				seen[item.IP] = true
+				ips = append(ips, item.IP)
+			}
+		}
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+
+		offset = offset + limit
+	}
+
+	return ips, nil
+}
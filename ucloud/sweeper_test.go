@@ -0,0 +1,300 @@
+package ucloud
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/ucloud/ucloud-sdk-go/services/udisk"
+	"github.com/ucloud/ucloud-sdk-go/services/uhost"
+	"github.com/ucloud/ucloud-sdk-go/services/ulb"
+	"github.com/ucloud/ucloud-sdk-go/services/unet"
+	"github.com/ucloud/ucloud-sdk-go/services/vpc"
+	"github.com/ucloud/ucloud-sdk-go/ucloud"
+)
+
+// testSweepNamePrefix is the name prefix of all of resources created by acceptance tests,
+// only the resources with this prefix will be destroyed by sweepers.
+const testSweepNamePrefix = "tf-acc-"
+
+// TestMain is the entry of tests, it will run the sweepers instead of tests if -sweep is set,
+// such as `go test ./ucloud -v -sweep=cn-bj2`.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sharedClientForRegion will returns a client for sweepers, the credentials is the same as acceptance tests.
+func sharedClientForRegion(region string) (*UCloudClient, error) {
+	startFakeAPIIfEnabled()
+
+	config := Config{
+		PublicKey:             os.Getenv("UCLOUD_PUBLIC_KEY"),
+		PrivateKey:            os.Getenv("UCLOUD_PRIVATE_KEY"),
+		Region:                region,
+		ProjectId:             os.Getenv("UCLOUD_PROJECT_ID"),
+		SharedCredentialsFile: os.Getenv("UCLOUD_SHARED_CREDENTIALS_FILE"),
+		Profile:               os.Getenv("UCLOUD_PROFILE"),
+		MaxRetries:            defaultMaxRetries,
+		BaseUrl:               os.Getenv("UCLOUD_BASE_URL"),
+		Endpoints:             map[string]string{},
+	}
+
+	if err := config.loadSharedCredentials(); err != nil {
+		return nil, err
+	}
+
+	if config.PublicKey == "" || config.PrivateKey == "" || config.ProjectId == "" {
+		return nil, fmt.Errorf("UCLOUD_PUBLIC_KEY, UCLOUD_PRIVATE_KEY and UCLOUD_PROJECT_ID must be set for sweepers")
+	}
+
+	return config.Client()
+}
+
+// isSweepableName will returns true if the resource is created by acceptance tests
+func isSweepableName(name string) bool {
+	return strings.HasPrefix(name, testSweepNamePrefix)
+}
+
+// testSweepDeleteResource will destroy the remote resource by the delete function of resource,
+// the attributes are required by some of delete functions, such as the parent id of resource.
+func testSweepDeleteResource(client *UCloudClient, r *schema.Resource, id string, attributes map[string]interface{}) error {
+	d := r.Data(nil)
+	d.SetId(id)
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error on setting %s of %s, %s", k, id, err)
+		}
+	}
+
+	return r.Delete(d, client)
+}
+
+// testSweepErrors is used to collect the errors of sweeper, the sweeper will keep going on failure
+type testSweepErrors []string
+
+func (e *testSweepErrors) add(err error) {
+	*e = append(*e, err.Error())
+}
+
+func (e testSweepErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(e, "\n"))
+}
+
+func testSweepListZones(client *UCloudClient) ([]string, error) {
+	conn := client.uaccountconn
+	resp, err := conn.GetRegion(conn.NewGetRegionRequest())
+	if err != nil {
+		return nil, fmt.Errorf("error on reading region list, %s", err)
+	}
+
+	var zones []string
+	for _, item := range resp.Regions {
+		if item.Region == client.region {
+			zones = append(zones, item.Zone)
+		}
+	}
+	return zones, nil
+}
+
+func testSweepListInstances(client *UCloudClient) ([]uhost.UHostInstanceSet, error) {
+	conn := client.uhostconn
+	req := conn.NewDescribeUHostInstanceRequest()
+
+	var instances []uhost.UHostInstanceSet
+	limit := 100
+	for offset := 0; ; offset += limit {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeUHostInstance(req)
+		if err != nil {
+			return nil, fmt.Errorf("error on reading instance list, %s", err)
+		}
+
+		for _, item := range resp.UHostSet {
+			if isSweepableName(item.Name) {
+				instances = append(instances, item)
+			}
+		}
+
+		if len(resp.UHostSet) < limit {
+			break
+		}
+	}
+	return instances, nil
+}
+
+func testSweepListDisks(client *UCloudClient) ([]udisk.UDiskDataSet, error) {
+	conn := client.udiskconn
+	req := conn.NewDescribeUDiskRequest()
+
+	var disks []udisk.UDiskDataSet
+	limit := 100
+	for offset := 0; ; offset += limit {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeUDisk(req)
+		if err != nil {
+			return nil, fmt.Errorf("error on reading disk list, %s", err)
+		}
+
+		for _, item := range resp.DataSet {
+			if isSweepableName(item.Name) {
+				disks = append(disks, item)
+			}
+		}
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+	}
+	return disks, nil
+}
+
+func testSweepListEIPs(client *UCloudClient) ([]unet.UnetEIPSet, error) {
+	conn := client.unetconn
+	req := conn.NewDescribeEIPRequest()
+
+	var eips []unet.UnetEIPSet
+	limit := 100
+	for offset := 0; ; offset += limit {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeEIP(req)
+		if err != nil {
+			return nil, fmt.Errorf("error on reading eip list, %s", err)
+		}
+
+		for _, item := range resp.EIPSet {
+			if isSweepableName(item.Name) {
+				eips = append(eips, item)
+			}
+		}
+
+		if len(resp.EIPSet) < limit {
+			break
+		}
+	}
+	return eips, nil
+}
+
+func testSweepListSecurityGroups(client *UCloudClient) ([]unet.FirewallDataSet, error) {
+	conn := client.unetconn
+	req := conn.NewDescribeFirewallRequest()
+
+	var sgs []unet.FirewallDataSet
+	limit := 100
+	for offset := 0; ; offset += limit {
+		req.Limit = ucloud.String(strconv.Itoa(limit))
+		req.Offset = ucloud.String(strconv.Itoa(offset))
+		resp, err := conn.DescribeFirewall(req)
+		if err != nil {
+			return nil, fmt.Errorf("error on reading security group list, %s", err)
+		}
+
+		for _, item := range resp.DataSet {
+			if isSweepableName(item.Name) {
+				sgs = append(sgs, item)
+			}
+		}
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+	}
+	return sgs, nil
+}
+
+func testSweepListShareBandwidths(client *UCloudClient) ([]unet.UnetShareBandwidthSet, error) {
+	conn := client.unetconn
+	resp, err := conn.DescribeShareBandwidth(conn.NewDescribeShareBandwidthRequest())
+	if err != nil {
+		return nil, fmt.Errorf("error on reading share bandwidth list, %s", err)
+	}
+
+	var shareBandwidths []unet.UnetShareBandwidthSet
+	for _, item := range resp.DataSet {
+		if isSweepableName(item.Name) {
+			shareBandwidths = append(shareBandwidths, item)
+		}
+	}
+	return shareBandwidths, nil
+}
+
+func testSweepListLBs(client *UCloudClient) ([]ulb.ULBSet, error) {
+	conn := client.ulbconn
+	req := conn.NewDescribeULBRequest()
+
+	var lbs []ulb.ULBSet
+	limit := 100
+	for offset := 0; ; offset += limit {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeULB(req)
+		if err != nil {
+			return nil, fmt.Errorf("error on reading lb list, %s", err)
+		}
+
+		for _, item := range resp.DataSet {
+			if isSweepableName(item.Name) {
+				lbs = append(lbs, item)
+			}
+		}
+
+		if len(resp.DataSet) < limit {
+			break
+		}
+	}
+	return lbs, nil
+}
+
+func testSweepListVPCs(client *UCloudClient) ([]vpc.VPCInfo, error) {
+	conn := client.vpcconn
+	resp, err := conn.DescribeVPC(conn.NewDescribeVPCRequest())
+	if err != nil {
+		return nil, fmt.Errorf("error on reading vpc list, %s", err)
+	}
+
+	var vpcs []vpc.VPCInfo
+	for _, item := range resp.DataSet {
+		if isSweepableName(item.Name) {
+			vpcs = append(vpcs, item)
+		}
+	}
+	return vpcs, nil
+}
+
+func testSweepListCustomImages(client *UCloudClient) ([]uhost.UHostImageSet, error) {
+	conn := client.uhostconn
+	req := conn.NewDescribeImageRequest()
+	req.ImageType = ucloud.String("Custom")
+
+	var images []uhost.UHostImageSet
+	limit := 100
+	for offset := 0; ; offset += limit {
+		req.Limit = ucloud.Int(limit)
+		req.Offset = ucloud.Int(offset)
+		resp, err := conn.DescribeImage(req)
+		if err != nil {
+			return nil, fmt.Errorf("error on reading custom image list, %s", err)
+		}
+
+		for _, item := range resp.ImageSet {
+			if isSweepableName(item.ImageName) {
+				images = append(images, item)
+			}
+		}
+
+		if len(resp.ImageSet) < limit {
+			break
+		}
+	}
+	return images, nil
+}
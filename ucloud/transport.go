@@ -0,0 +1,188 @@
+package ucloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// throttlingRetCodes is the set of RetCode which means the request is throttled by server
+var throttlingRetCodes = map[int]bool{
+	172: true,
+}
+
+// ucloudTransport is a http.RoundTripper which limits the rate and concurrency of api requests,
+// and retries the requests which are throttled by server with exponential backoff.
+// Each client has its own transport, it is set as the transport of sdk config.
+type ucloudTransport struct {
+	// base is used to send the request, http.DefaultTransport is used if it is nil
+	base http.RoundTripper
+
+	limiter    *requestLimiter
+	maxRetries int
+
+	// timeout is the timeout of each attempt, the backoff delay between attempts is not included
+	timeout time.Duration
+
+	auditLogger *auditLogger
+}
+
+// newUCloudTransport will returns the transport with the limiter and audit logger of config
+func newUCloudTransport(c *Config, timeout time.Duration) (*ucloudTransport, error) {
+	auditLogger, err := newAuditLogger(c.LogFile, c.LogFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ucloudTransport{
+		limiter:     newRequestLimiter(c.MaxRequestsPerSecond, c.MaxConcurrentRequests),
+		maxRetries:  c.MaxRetries,
+		timeout:     timeout,
+		auditLogger: auditLogger,
+	}, nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *ucloudTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	for retryCount := 0; ; retryCount++ {
+		resp, body, err := t.roundTrip(req)
+
+		// sdk request has no body, so it is safe to send it again
+		if err != nil || retryCount >= t.maxRetries || !isRequestReplayable(req) || !isThrottlingResponse(resp, body) {
+			t.auditLogger.log(newAuditRecord(req, resp, body, err, time.Since(start)))
+			return resp, err
+		}
+
+		select {
+		case <-time.After(getThrottlingBackoffDelay(retryCount)):
+		case <-req.Context().Done():
+			err := req.Context().Err()
+			t.auditLogger.log(newAuditRecord(req, nil, nil, err, time.Since(start)))
+			return nil, err
+		}
+	}
+}
+
+func (t *ucloudTransport) roundTrip(req *http.Request) (*http.Response, []byte, error) {
+	t.limiter.acquire()
+	defer t.limiter.release()
+
+	if t.timeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the body is read before release limiter and cancel the attempt,
+	// so that the concurrency and timeout include reading response
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return resp, body, nil
+}
+
+func isRequestReplayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody
+}
+
+func isThrottlingResponse(resp *http.Response, body []byte) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	var common struct {
+		RetCode int
+	}
+
+	if err := json.Unmarshal(body, &common); err != nil {
+		return false
+	}
+
+	return throttlingRetCodes[common.RetCode]
+}
+
+func getThrottlingBackoffDelay(retryCount int) time.Duration {
+	minTime := 500
+	if retryCount > 5 {
+		retryCount = 5
+	}
+
+	delay := (1 << uint(retryCount)) * (rand.Intn(minTime) + minTime)
+	return time.Duration(delay) * time.Millisecond
+}
+
+// requestLimiter is used to limit the rate and concurrency of requests,
+// zero value of rate or concurrency means unlimited.
+type requestLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+
+	semaphore chan struct{}
+}
+
+func newRequestLimiter(maxRequestsPerSecond, maxConcurrentRequests int) *requestLimiter {
+	limiter := &requestLimiter{}
+
+	if maxRequestsPerSecond > 0 {
+		limiter.interval = time.Second / time.Duration(maxRequestsPerSecond)
+	}
+
+	if maxConcurrentRequests > 0 {
+		limiter.semaphore = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	return limiter
+}
+
+// acquire will block until the request is allowed to send
+func (l *requestLimiter) acquire() {
+	if l.semaphore != nil {
+		l.semaphore <- struct{}{}
+	}
+
+	if l.interval == 0 {
+		return
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	wait := l.next.Sub(now)
+	if wait > 0 {
+		l.next = l.next.Add(l.interval)
+	} else {
+		l.next = now.Add(l.interval)
+	}
+	l.mu.Unlock()
+
+	if wait > 0 {
+		<-time.After(wait)
+	}
+}
+
+// release will mark the request is completed
+func (l *requestLimiter) release() {
+	if l.semaphore != nil {
+		<-l.semaphore
+	}
+}
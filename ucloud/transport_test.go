@@ -0,0 +1,117 @@
+package ucloud
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_ucloudTransport_throttling(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			fmt.Fprint(w, `{"RetCode": 172, "Message": "too many requests"}`)
+			return
+		}
+		fmt.Fprint(w, `{"RetCode": 0}`)
+	}))
+	defer server.Close()
+
+	transport, err := newUCloudTransport(&Config{MaxRetries: 1}, 0)
+	if err != nil {
+		t.Fatalf("newUCloudTransport() error = %v", err)
+	}
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := ioutil.ReadAll(resp.Body)
+	if string(body) != `{"RetCode": 0}` {
+		t.Errorf("body = %s, want the response after retry", body)
+	}
+
+	if count != 2 {
+		t.Errorf("request count = %d, want 2", count)
+	}
+}
+
+func Test_ucloudTransport_cancelBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"RetCode": 172, "Message": "too many requests"}`)
+	}))
+	defer server.Close()
+
+	transport, err := newUCloudTransport(&Config{MaxRetries: 10}, time.Second)
+	if err != nil {
+		t.Fatalf("newUCloudTransport() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	start := time.Now()
+	if _, err := transport.RoundTrip(req.WithContext(ctx)); err != context.DeadlineExceeded {
+		t.Errorf("RoundTrip() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// the first backoff delay is at least 500ms
+	if elapsed := time.Since(start); elapsed > 400*time.Millisecond {
+		t.Errorf("elapsed = %v, want the backoff is cancelled", elapsed)
+	}
+}
+
+func Test_requestLimiter_concurrency(t *testing.T) {
+	limiter := newRequestLimiter(0, 2)
+
+	var current, max int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limiter.acquire()
+			defer limiter.release()
+
+			n := atomic.AddInt32(&current, 1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&current, -1)
+		}()
+	}
+	wg.Wait()
+
+	if max > 2 {
+		t.Errorf("max concurrent requests = %d, want at most 2", max)
+	}
+}
+
+func Test_requestLimiter_rate(t *testing.T) {
+	limiter := newRequestLimiter(20, 0)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		limiter.acquire()
+		limiter.release()
+	}
+
+	// the first request is sent immediately, the others are delayed by 50ms
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("elapsed = %v, want at least 200ms", elapsed)
+	}
+}
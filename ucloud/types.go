@@ -83,6 +83,26 @@ func (c *cidrBlock) String() string {
 	return fmt.Sprintf("%s/%v", c.Network, c.Mask)
 }
 
+// Contains will check if the ip address is included by the network of cidr block
+func (c *cidrBlock) Contains(ip string) bool {
+	_, ipNet, err := net.ParseCIDR(c.String())
+	if err != nil {
+		return false
+	}
+
+	return ipNet.Contains(net.ParseIP(ip))
+}
+
+// ContainsCidr will check if the network of other cidr block is included by the network of cidr block
+func (c *cidrBlock) ContainsCidr(other *cidrBlock) bool {
+	return c.Mask <= other.Mask && c.Contains(other.Network)
+}
+
+// IPCount will returns the number of ip addresses in the network of cidr block
+func (c *cidrBlock) IPCount() int {
+	return 1 << uint(32-c.Mask)
+}
+
 type instanceType struct {
 	CPU           int
 	Memory        int
@@ -94,6 +94,64 @@ func Test_parseUCloudCidrBlock(t *testing.T) {
 	}
 }
 
+func Test_cidrBlock_Contains(t *testing.T) {
+	tests := []struct {
+		name string
+		cidr string
+		ip   string
+		want bool
+	}{
+		{"ok", "192.168.1.0/24", "192.168.1.10", true},
+		{"ok_network_boundary", "10.9.0.0/16", "10.9.255.254", true},
+
+		{"err_out_of_network", "192.168.1.0/24", "192.168.2.10", false},
+		{"err_invalid_ip", "192.168.1.0/24", "192.168.1", false},
+		{"err_empty", "192.168.1.0/24", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cidr, err := parseCidrBlock(tt.cidr)
+			if err != nil {
+				t.Fatalf("parseCidrBlock() error = %v", err)
+			}
+			if got := cidr.Contains(tt.ip); got != tt.want {
+				t.Errorf("cidrBlock.Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cidrBlock_ContainsCidr(t *testing.T) {
+	tests := []struct {
+		name  string
+		cidr  string
+		other string
+		want  bool
+	}{
+		{"ok", "192.168.0.0/16", "192.168.1.0/24", true},
+		{"ok_equal", "192.168.1.0/24", "192.168.1.0/24", true},
+		{"ok_single_ip", "10.9.0.0/16", "10.9.1.5/32", true},
+
+		{"err_out_of_network", "192.168.1.0/24", "192.168.2.0/24", false},
+		{"err_larger_network", "192.168.1.0/24", "192.168.0.0/16", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cidr, err := parseCidrBlock(tt.cidr)
+			if err != nil {
+				t.Fatalf("parseCidrBlock() error = %v", err)
+			}
+			other, err := parseCidrBlock(tt.other)
+			if err != nil {
+				t.Fatalf("parseCidrBlock() error = %v", err)
+			}
+			if got := cidr.ContainsCidr(other); got != tt.want {
+				t.Errorf("cidrBlock.ContainsCidr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_parseAssociationInfo(t *testing.T) {
 	type args struct {
 		assocId string
@@ -1,6 +1,7 @@
 package ucloud
 
 import (
+	"encoding/base64"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -56,6 +57,53 @@ func validateInstancePassword(v interface{}, k string) (ws []string, errors []er
 	return
 }
 
+var instanceKeyPairPattern = regexp.MustCompile(`^(ssh-rsa|ssh-ed25519|ecdsa-sha2-nistp(256|384|521)) [A-Za-z0-9+/]+={0,3}( .*)?$`)
+
+func validateInstanceKeyPair(v interface{}, k string) (ws []string, errors []error) {
+	value := strings.TrimSpace(v.(string))
+	if !instanceKeyPairPattern.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q is invalid, should be a public key in OpenSSH format, such as \"ssh-rsa AAAA...\"", k))
+	}
+
+	return
+}
+
+// instanceUserDataMaxSize is the max size of user data after base64 encoded
+const instanceUserDataMaxSize = 16 * 1024
+
+func validateInstanceUserData(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if size := base64.StdEncoding.EncodedLen(len(value)); size > instanceUserDataMaxSize {
+		errors = append(errors, fmt.Errorf("%q is invalid, should be at most %d bytes after base64 encoded, got %d", k, instanceUserDataMaxSize, size))
+	}
+
+	return
+}
+
+func validateInstanceUserDataBase64(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is invalid, should be a base64 encoded string, got error %s", k, err))
+	}
+
+	if len(value) > instanceUserDataMaxSize {
+		errors = append(errors, fmt.Errorf("%q is invalid, should be at most %d bytes, got %d", k, instanceUserDataMaxSize, len(value)))
+	}
+
+	return
+}
+
+var instancePrivateMacPattern = regexp.MustCompile(`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`)
+
+func validateInstancePrivateMac(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !instancePrivateMacPattern.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q is invalid, should like xx:xx:xx:xx:xx:xx, got %q", k, value))
+	}
+
+	return
+}
+
 func validateSecurityGroupPort(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 